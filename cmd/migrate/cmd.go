@@ -14,6 +14,7 @@ import (
 
 	"github.com/webitel/im-account-service/cmd"
 	"github.com/webitel/im-account-service/config"
+	"github.com/webitel/im-account-service/infra/secrets"
 	"github.com/webitel/im-account-service/migrations"
 )
 
@@ -27,6 +28,11 @@ func CMD() *cli.Command {
 				Aliases: []string{"c"},
 				Usage:   "Path to the configuration file",
 			},
+			&cli.Int64Flag{
+				Name:  "down_to",
+				Usage: "Roll back the migrations down to the given version ; (0): all",
+				Value: -1, // none ; migrate up
+			},
 		},
 		Action: func(c *cli.Context) error {
 
@@ -41,7 +47,14 @@ func CMD() *cli.Command {
 					cmd.ProvideLogger,
 				),
 				fx.Invoke(func(cfg *config.Config, log *slog.Logger, lc fx.Lifecycle) error {
-					m := NewMigrator(cfg, log)
+					keys, err := cmd.ProvideKeyring(cfg, log)
+					if err != nil {
+						return err
+					}
+					m := NewMigrator(cfg, log, keys)
+					if version := c.Int64("down_to"); version >= 0 {
+						return m.DownTo(c.Context, version)
+					}
 					if err := m.Run(c.Context); err != nil {
 						return err
 					}
@@ -61,18 +74,33 @@ func init() {
 }
 
 type migrator struct {
-	cfg *config.Config
-	log *slog.Logger
+	cfg  *config.Config
+	log  *slog.Logger
+	keys *secrets.Keyring // OPTIONAL ; app secrets
 }
 
-func NewMigrator(cfg *config.Config, log *slog.Logger) *migrator {
+func NewMigrator(cfg *config.Config, log *slog.Logger, keys *secrets.Keyring) *migrator {
 	return &migrator{
-		cfg: cfg,
-		log: log,
+		cfg:  cfg,
+		log:  log,
+		keys: keys,
 	}
 }
 
 func (m *migrator) Run(ctx context.Context) error {
+	return m.run(ctx, func(provider *goose.Provider) ([]*goose.MigrationResult, error) {
+		return provider.Up(ctx)
+	})
+}
+
+// DownTo rolls back the migrations down to the [version] ; (0): all.
+func (m *migrator) DownTo(ctx context.Context, version int64) error {
+	return m.run(ctx, func(provider *goose.Provider) ([]*goose.MigrationResult, error) {
+		return provider.DownTo(ctx, version)
+	})
+}
+
+func (m *migrator) run(ctx context.Context, migrate func(*goose.Provider) ([]*goose.MigrationResult, error)) error {
 	conf, err := pgxpool.ParseConfig(m.cfg.Postgres.DSN)
 	if err != nil {
 		return err
@@ -89,12 +117,14 @@ func (m *migrator) Run(ctx context.Context) error {
 	}
 
 	noopDialect := goose.Dialect("")
-	provider, err := goose.NewProvider(noopDialect, db, migrations.EmbedMigrations, goose.WithStore(store))
+	provider, err := goose.NewProvider(noopDialect, db, migrations.EmbedMigrations, goose.WithStore(store),
+		goose.WithGoMigrations(migrations.GoMigrations(m.keys)...),
+	)
 	if err != nil {
 		return err
 	}
 
-	res, err := provider.Up(ctx)
+	res, err := migrate(provider)
 	if err != nil {
 		return err
 	}
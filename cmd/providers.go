@@ -18,14 +18,24 @@ import (
 	"github.com/ThreeDotsLabs/watermill/message/router/middleware"
 	"github.com/lmittmann/tint"
 	"github.com/mattn/go-isatty"
+	"github.com/redis/go-redis/v9"
 	"github.com/webitel/im-account-service/config"
 	"github.com/webitel/im-account-service/infra/db/pg"
 	"github.com/webitel/im-account-service/infra/pubsub"
 	"github.com/webitel/im-account-service/infra/pubsub/factory"
 	"github.com/webitel/im-account-service/infra/pubsub/factory/amqp"
+	"github.com/webitel/im-account-service/infra/secrets"
 	grpc_srv "github.com/webitel/im-account-service/infra/server/grpc"
+	"github.com/webitel/im-account-service/infra/state"
+	"github.com/webitel/im-account-service/infra/state/memory"
+	staterds "github.com/webitel/im-account-service/infra/state/redis"
 	infra_tls "github.com/webitel/im-account-service/infra/tls"
 	"github.com/webitel/im-account-service/infra/x/logx"
+	"github.com/webitel/im-account-service/internal/model"
+	"github.com/webitel/im-account-service/internal/push/dispatch"
+	"github.com/webitel/im-account-service/internal/updates"
+	"github.com/webitel/im-account-service/internal/updates/partner"
+	"github.com/webitel/im-account-service/internal/updates/webhook"
 	"github.com/webitel/webitel-go-kit/infra/discovery"
 	_ "github.com/webitel/webitel-go-kit/infra/discovery/consul"
 	otelsdk "github.com/webitel/webitel-go-kit/infra/otel/sdk"
@@ -404,3 +414,177 @@ func ProvideNewDBConnection(config *config.Config, logger *slog.Logger, runtime
 
 	return db, err
 }
+
+// ProvideState returns the shared state backend for rate-limit buckets,
+// one-time codes and revocation markers, chosen by config.
+func ProvideState(config *config.Config, runtime fx.Lifecycle) (state.Store, error) {
+
+	driver := strings.ToLower(config.State.Driver)
+	switch driver {
+	case "", "memory":
+		store := memory.New(time.Minute)
+		runtime.Append(fx.Hook{
+			OnStop: func(_ context.Context) error {
+				return store.Close()
+			},
+		})
+		return store, nil
+	case "redis":
+		client := redis.NewClient(&redis.Options{
+			Addr:     config.Redis.Addr,
+			Password: config.Redis.Password,
+			DB:       config.Redis.DB,
+		})
+		runtime.Append(fx.Hook{
+			OnStart: func(ctx context.Context) error {
+				if err := client.Ping(ctx).Err(); err != nil {
+					return fmt.Errorf("redis [%s]: %w", config.Redis.Addr, err)
+				}
+				return nil
+			},
+			OnStop: func(_ context.Context) error {
+				return client.Close()
+			},
+		})
+		return staterds.New(client), nil
+	default:
+		return nil, fmt.Errorf("state [%s] not supported", driver)
+	}
+}
+
+// ProvideKeyring returns the app config secrets key-encryption keyring.
+// Returns nil if not configured ; secrets are stored as plaintext (!)
+func ProvideKeyring(config *config.Config, logger *slog.Logger) (*secrets.Keyring, error) {
+
+	conf := config.Secrets
+	keys := make(map[string][]byte)
+	for _, source := range []func() (map[string][]byte, error){
+		func() (map[string][]byte, error) {
+			return secrets.ParseKeys(conf.Key, conf.KeyId)
+		},
+		func() (map[string][]byte, error) {
+			if conf.KeyFile == "" {
+				return nil, nil
+			}
+			return secrets.LoadKeys(conf.KeyFile, conf.KeyId)
+		},
+	} {
+		more, err := source()
+		if err != nil {
+			return nil, err
+		}
+		for kid, key := range more {
+			if _, dup := keys[kid]; dup {
+				return nil, fmt.Errorf("secrets: key( %s ); duplicate", kid)
+			}
+			keys[kid] = key
+		}
+	}
+
+	if len(keys) == 0 {
+		logger.Warn("[ SECRETS ] no key-encryption key configured ; app secrets are stored as plaintext")
+		return nil, nil
+	}
+
+	return secrets.NewKeyring(conf.KeyId, keys)
+}
+
+// ProvideClientSecretPolicy returns the App [client_secret] rotation rules.
+func ProvideClientSecretPolicy(config *config.Config) model.ClientSecretPolicy {
+	return model.ClientSecretPolicy{
+		Overlap:    config.Client.SecretOverlap,
+		MaxOverlap: config.Client.SecretMaxOverlap,
+	}
+}
+
+// ProvidePushDispatcher returns the PUSH notification(s) sender.
+func ProvidePushDispatcher(config *config.Config, logger *slog.Logger, runtime fx.Lifecycle) *dispatch.Dispatcher {
+	sender := dispatch.New(dispatch.Options{
+		Logger: logx.ModuleLogger("push", logger),
+		Limits: map[string]int{
+			dispatch.FCM: config.Push.FCMLimit,
+			dispatch.APN: config.Push.APNLimit,
+			dispatch.Web: config.Push.WebLimit,
+		},
+	})
+	runtime.Append(fx.Hook{
+		OnStop: func(_ context.Context) error {
+			sender.Close()
+			return nil
+		},
+	})
+	return sender
+}
+
+// ProvidePushTokenPolicy returns the device PUSH token(s) expiration rules.
+func ProvidePushTokenPolicy(config *config.Config) model.PushTokenPolicy {
+	return model.PushTokenPolicy{
+		IdleTimeout:   config.Push.TokenIdle,
+		SweepInterval: config.Push.SweepInterval,
+	}
+}
+
+// ProvidePushOutboxPolicy returns the PUSH delivery outbox worker(s) and retry rules.
+func ProvidePushOutboxPolicy(config *config.Config) model.PushOutboxPolicy {
+	return model.PushOutboxPolicy{
+		Workers:      config.Push.Workers,
+		Batch:        config.Push.Batch,
+		PollInterval: config.Push.PollInterval,
+		Lease:        config.Push.Lease,
+		MaxAttempts:  config.Push.MaxAttempts,
+		Backoff:      config.Push.RetryBackoff,
+		MaxBackoff:   config.Push.RetryMaxBackoff,
+		Retention:    config.Push.DeadRetention,
+	}
+}
+
+// ProvideUpdateOutboxPolicy returns the App Update(s) delivery outbox worker(s) and retry rules.
+func ProvideUpdateOutboxPolicy(config *config.Config) model.UpdateOutboxPolicy {
+	return model.UpdateOutboxPolicy{
+		PushOutboxPolicy: model.PushOutboxPolicy{
+			Workers:      config.Updates.Workers,
+			Batch:        config.Updates.Batch,
+			PollInterval: config.Updates.PollInterval,
+			Lease:        config.Updates.Lease,
+			MaxAttempts:  config.Updates.MaxAttempts,
+			Backoff:      config.Updates.RetryBackoff,
+			MaxBackoff:   config.Updates.RetryMaxBackoff,
+			Retention:    config.Updates.DeadRetention,
+		},
+		Timeout: config.Updates.Timeout,
+	}
+}
+
+// ProvideWebhookSender returns the App Update(s) and login hook webhook endpoint client.
+func ProvideWebhookSender(config *config.Config) updates.Client {
+	return webhook.New(config.Updates.Timeout)
+}
+
+// ProvidePartnerSender returns the App Update(s) and login hook partner service(s) client.
+// Pooled connection(s) are closed on shutdown.
+func ProvidePartnerSender(config *config.Config, runtime fx.Lifecycle) updates.BatchClient {
+	client := partner.New(config.Updates.Timeout)
+	runtime.Append(fx.StopHook(client.Close))
+	return client
+}
+
+// ProvideAccountEventPolicy returns the account domain event(s) broker relay rules.
+func ProvideAccountEventPolicy(config *config.Config) model.AccountEventPolicy {
+	return model.AccountEventPolicy{
+		Enabled:      config.Events.Relay,
+		Exchange:     config.Events.Exchange,
+		Batch:        config.Events.Batch,
+		PollInterval: config.Events.PollInterval,
+		Lease:        config.Events.Lease,
+	}
+}
+
+// ProvideSessionActivityPolicy returns the session activity tracking rules.
+func ProvideSessionActivityPolicy(config *config.Config) model.SessionActivityPolicy {
+	return model.SessionActivityPolicy{
+		Interval:      config.Sessions.ActivityInterval,
+		History:       config.Sessions.ActivityHistory,
+		FlushInterval: config.Sessions.FlushInterval,
+		FlushSize:     config.Sessions.FlushSize,
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/webitel/im-account-service/cmd"
 	"github.com/webitel/im-account-service/config"
 	grpcsrv "github.com/webitel/im-account-service/infra/server/grpc"
+	"github.com/webitel/im-account-service/infra/state"
 	"github.com/webitel/im-account-service/infra/x/logx"
 	"github.com/webitel/im-account-service/internal/handler"
 	apiV1 "github.com/webitel/im-account-service/internal/handler/grpc/v1"
@@ -27,7 +28,20 @@ func NewApp(cfg *config.Config) *fx.App {
 			cmd.ProvideSD,
 			cmd.ProvidePubSub,
 			cmd.ProvideNewDBConnection,
+			cmd.ProvideState,
+			cmd.ProvideKeyring,
+			cmd.ProvideClientSecretPolicy,
+			cmd.ProvidePushDispatcher,
+			cmd.ProvidePushTokenPolicy,
+			cmd.ProvidePushOutboxPolicy,
+			cmd.ProvideUpdateOutboxPolicy,
+			cmd.ProvideWebhookSender,
+			cmd.ProvidePartnerSender,
+			cmd.ProvideAccountEventPolicy,
+			cmd.ProvideSessionActivityPolicy,
 		),
+		// Shared state backend ; validate the driver (and connect) at startup
+		fx.Invoke(func(state.Store) {}),
 		fx.WithLogger(func(stdlog *slog.Logger) fxevent.Logger {
 			const debugLog = slog.LevelDebug
 			if !(logx.Debug("fx") && stdlog.Enabled(context.TODO(), debugLog)) {
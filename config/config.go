@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/fsnotify/fsnotify"
 	"github.com/spf13/pflag"
@@ -15,6 +16,13 @@ type Config struct {
 	Log      LogConfig      `mapstructure:"log"`
 	Postgres PostgresConfig `mapstructure:"postgres"`
 	Redis    RedisConfig    `mapstructure:"redis"`
+	State    StateConfig    `mapstructure:"state"`
+	Secrets  SecretsConfig  `mapstructure:"secrets"`
+	Client   ClientConfig   `mapstructure:"client"`
+	Push     PushConfig     `mapstructure:"push"`
+	Updates  UpdatesConfig  `mapstructure:"updates"`
+	Events   EventsConfig   `mapstructure:"events"`
+	Sessions SessionsConfig `mapstructure:"sessions"`
 	Consul   ConsulConfig   `mapstructure:"consul"`
 	Pubsub   PubsubConfig   `mapstructure:"pubsub"`
 }
@@ -55,6 +63,106 @@ type RedisConfig struct {
 	DB       int    `mapstructure:"db"`
 }
 
+// StateConfig selects the shared state backend
+// for rate-limit buckets, one-time codes and revocation markers.
+type StateConfig struct {
+	// Driver: memory (single node), redis (cluster)
+	Driver string `mapstructure:"driver"`
+}
+
+// SecretsConfig of the [K]ey-[E]ncryption-[K]ey(s)
+// to seal the app config secret(s) at rest.
+type SecretsConfig struct {
+	// Active key ID to encrypt NEW secret(s)
+	KeyId string `mapstructure:"kek_id"`
+	// Key(s) source: [kid:]base64 ; comma separated
+	Key string `mapstructure:"kek"`
+	// Key(s) file: [kid:]base64 ; line(s)
+	KeyFile string `mapstructure:"kek_file"`
+}
+
+// ClientConfig of the App [client_secret] credential(s) rotation.
+type ClientConfig struct {
+	// Default overlap window of the current secret(s) on rotation
+	SecretOverlap time.Duration `mapstructure:"secret_overlap"`
+	// Maximum overlap window allowed to request
+	SecretMaxOverlap time.Duration `mapstructure:"secret_max_overlap"`
+}
+
+// PushConfig of the PUSH notification(s) delivery.
+type PushConfig struct {
+	// Concurrent requests limit per provider
+	FCMLimit int `mapstructure:"fcm_limit"`
+	APNLimit int `mapstructure:"apn_limit"`
+	WebLimit int `mapstructure:"web_limit"`
+	// Expire device token(s) of the session(s) idle for this period ; zero: never
+	TokenIdle time.Duration `mapstructure:"token_idle"`
+	// Interval to sweep the idle session(s) device token(s)
+	SweepInterval time.Duration `mapstructure:"sweep_interval"`
+	// Delivery outbox worker(s) per node ; zero: disabled on this node
+	Workers int `mapstructure:"workers"`
+	// Record(s) claimed per worker at once
+	Batch int `mapstructure:"batch"`
+	// Interval to poll for the pending record(s), if idle
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	// Claimed record(s) lease ; re-claimed after
+	Lease time.Duration `mapstructure:"lease"`
+	// Delivery attempt(s) before the dead-letter
+	MaxAttempts int `mapstructure:"max_attempts"`
+	// Initial and maximum retry delay ; exponential backoff
+	RetryBackoff    time.Duration `mapstructure:"retry_backoff"`
+	RetryMaxBackoff time.Duration `mapstructure:"retry_max_backoff"`
+	// Keep dead-letter record(s) for this period ; zero: forever
+	DeadRetention time.Duration `mapstructure:"dead_retention"`
+}
+
+// UpdatesConfig of the App Update(s) delivery ; [service.send_update] subscription.
+type UpdatesConfig struct {
+	// Delivery outbox worker(s) per node ; zero: disabled on this node
+	Workers int `mapstructure:"workers"`
+	// Record(s) claimed per worker at once
+	Batch int `mapstructure:"batch"`
+	// Interval to poll for the pending record(s), if idle
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	// Claimed record(s) lease ; re-claimed after
+	Lease time.Duration `mapstructure:"lease"`
+	// Delivery attempt(s) before the dead-letter
+	MaxAttempts int `mapstructure:"max_attempts"`
+	// Initial and maximum retry delay ; exponential backoff
+	RetryBackoff    time.Duration `mapstructure:"retry_backoff"`
+	RetryMaxBackoff time.Duration `mapstructure:"retry_max_backoff"`
+	// Keep dead-letter record(s) for this period ; zero: forever
+	DeadRetention time.Duration `mapstructure:"dead_retention"`
+	// Endpoint request timeout
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// EventsConfig of the account domain event(s) broker relay.
+type EventsConfig struct {
+	// Publish the recorded event(s) from this node
+	Relay bool `mapstructure:"relay"`
+	// Broker topic exchange to publish to
+	Exchange string `mapstructure:"exchange"`
+	// Record(s) claimed at once
+	Batch int `mapstructure:"batch"`
+	// Interval to poll for the pending record(s), if idle
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	// Claimed record(s) lease ; re-claimed after
+	Lease time.Duration `mapstructure:"lease"`
+}
+
+// SessionsConfig of the session activity tracking.
+type SessionsConfig struct {
+	// Record the session activity at most once per interval ; zero: disabled
+	ActivityInterval time.Duration `mapstructure:"activity_interval"`
+	// Session IP address(es) history size
+	ActivityHistory int `mapstructure:"activity_history"`
+	// Write the buffered session(s) activity at this interval
+	FlushInterval time.Duration `mapstructure:"flush_interval"`
+	// Write the buffered session(s) activity once this many pending
+	FlushSize int `mapstructure:"flush_size"`
+}
+
 type ConsulConfig struct {
 	Address string `mapstructure:"addr"`
 }
@@ -142,6 +250,47 @@ func defineFlags() {
 	pflag.String("redis.password", "", "Redis password")
 	pflag.Int("redis.db", 0, "Redis database number")
 
+	pflag.String("state.driver", "memory", "Shared state driver: memory, redis")
+
+	pflag.String("secrets.kek_id", "", "Active key-encryption key ID")
+	pflag.String("secrets.kek", "", "Key-encryption key(s): [kid:]base64 ; comma separated")
+	pflag.String("secrets.kek_file", "", "Key-encryption key(s) file path")
+
+	pflag.Duration("client.secret_overlap", 24*time.Hour, "Default client_secret rotation overlap window")
+	pflag.Duration("client.secret_max_overlap", 30*24*time.Hour, "Maximum client_secret rotation overlap window")
+
+	pflag.Int("push.fcm_limit", 64, "Concurrent FCM requests limit")
+	pflag.Int("push.apn_limit", 64, "Concurrent APNs requests limit")
+	pflag.Int("push.web_limit", 64, "Concurrent Web PUSH requests limit")
+	pflag.Duration("push.token_idle", 60*24*time.Hour, "Expire device PUSH token(s) of sessions idle for this period ; 0: never")
+	pflag.Duration("push.sweep_interval", time.Hour, "Interval to sweep idle sessions device PUSH token(s)")
+	pflag.Int("push.workers", 4, "PUSH delivery outbox workers per node ; 0: disabled on this node")
+	pflag.Int("push.batch", 100, "PUSH delivery outbox records claimed per worker at once")
+	pflag.Duration("push.poll_interval", time.Second, "Interval to poll for pending PUSH deliveries, if idle")
+	pflag.Duration("push.lease", time.Minute, "Claimed PUSH delivery lease ; re-claimed after")
+	pflag.Int("push.max_attempts", 10, "PUSH delivery attempts before the dead-letter")
+	pflag.Duration("push.retry_backoff", 5*time.Second, "Initial PUSH delivery retry delay ; exponential backoff")
+	pflag.Duration("push.retry_max_backoff", time.Hour, "Maximum PUSH delivery retry delay")
+	pflag.Duration("push.dead_retention", 7*24*time.Hour, "Keep failed (dead-letter) PUSH deliveries for this period ; 0: forever")
+	pflag.Int("updates.workers", 4, "App updates delivery outbox workers per node ; 0: disabled on this node")
+	pflag.Int("updates.batch", 100, "App updates delivery outbox records claimed per worker at once")
+	pflag.Duration("updates.poll_interval", time.Second, "Interval to poll for pending App updates, if idle")
+	pflag.Duration("updates.lease", time.Minute, "Claimed App update lease ; re-claimed after")
+	pflag.Int("updates.max_attempts", 10, "App update delivery attempts before the dead-letter")
+	pflag.Duration("updates.retry_backoff", 5*time.Second, "Initial App update delivery retry delay ; exponential backoff")
+	pflag.Duration("updates.retry_max_backoff", time.Hour, "Maximum App update delivery retry delay")
+	pflag.Duration("updates.dead_retention", 7*24*time.Hour, "Keep failed (dead-letter) App updates for this period ; 0: forever")
+	pflag.Duration("updates.timeout", 10*time.Second, "App update endpoint request timeout")
+	pflag.Bool("events.relay", true, "Publish account domain events to the broker from this node")
+	pflag.String("events.exchange", "im_account", "Account domain events broker topic exchange")
+	pflag.Int("events.batch", 100, "Account domain events claimed at once")
+	pflag.Duration("events.poll_interval", time.Second, "Interval to poll for pending account domain events, if idle")
+	pflag.Duration("events.lease", 30*time.Second, "Claimed account domain events lease ; re-published after")
+	pflag.Duration("sessions.activity_interval", 5*time.Minute, "Record session activity at most once per interval ; 0: disabled")
+	pflag.Int("sessions.activity_history", 10, "Session IP address(es) history size")
+	pflag.Duration("sessions.flush_interval", time.Second, "Write buffered session activity at this interval")
+	pflag.Int("sessions.flush_size", 500, "Write buffered session activity once this many sessions pending")
+
 	pflag.String("consul.addr", "localhost:8500", "Consul address")
 
 	pflag.String("pubsub.broker_url", "", "PubSub broker URL")
@@ -170,8 +319,94 @@ func (c *Config) validate() error {
 		return fmt.Errorf("config: postgres.dsn is required (use --postgres.dsn or DATA_SOURCE env)")
 	}
 
-	if c.Redis.Addr == "" {
-		return fmt.Errorf("config: redis.addr is required")
+	switch strings.ToLower(c.State.Driver) {
+	case "", "memory":
+	case "redis":
+		if c.Redis.Addr == "" {
+			return fmt.Errorf("config: redis.addr is required when state.driver is redis")
+		}
+	default:
+		return fmt.Errorf("config: state.driver [%s] not supported", c.State.Driver)
+	}
+
+	if c.Secrets.KeyId == "" && (c.Secrets.Key != "" || c.Secrets.KeyFile != "") {
+		return fmt.Errorf("config: secrets.kek_id is required when key(s) specified")
+	}
+
+	if c.Client.SecretOverlap < 0 || c.Client.SecretMaxOverlap < 0 {
+		return fmt.Errorf("config: client.secret_overlap must not be negative")
+	}
+
+	if 0 < c.Client.SecretMaxOverlap && c.Client.SecretMaxOverlap < c.Client.SecretOverlap {
+		return fmt.Errorf("config: client.secret_overlap exceeds client.secret_max_overlap")
+	}
+
+	if c.Push.FCMLimit < 0 || c.Push.APNLimit < 0 || c.Push.WebLimit < 0 {
+		return fmt.Errorf("config: push.*_limit must not be negative")
+	}
+
+	if c.Push.TokenIdle < 0 || c.Push.SweepInterval < 0 {
+		return fmt.Errorf("config: push.token_idle and push.sweep_interval must not be negative")
+	}
+
+	if c.Push.Workers < 0 || c.Push.DeadRetention < 0 {
+		return fmt.Errorf("config: push.workers and push.dead_retention must not be negative")
+	}
+
+	if c.Push.Workers > 0 {
+		if c.Push.Batch < 1 || c.Push.MaxAttempts < 1 {
+			return fmt.Errorf("config: push.batch and push.max_attempts must be positive")
+		}
+		if c.Push.PollInterval <= 0 || c.Push.Lease <= 0 || c.Push.RetryBackoff <= 0 {
+			return fmt.Errorf("config: push.poll_interval, push.lease and push.retry_backoff must be positive")
+		}
+		if 0 < c.Push.RetryMaxBackoff && c.Push.RetryMaxBackoff < c.Push.RetryBackoff {
+			return fmt.Errorf("config: push.retry_backoff exceeds push.retry_max_backoff")
+		}
+	}
+
+	if c.Updates.Workers < 0 || c.Updates.DeadRetention < 0 || c.Updates.Timeout < 0 {
+		return fmt.Errorf("config: updates.workers, updates.dead_retention and updates.timeout must not be negative")
+	}
+
+	if c.Updates.Workers > 0 {
+		if c.Updates.Batch < 1 || c.Updates.MaxAttempts < 1 {
+			return fmt.Errorf("config: updates.batch and updates.max_attempts must be positive")
+		}
+		if c.Updates.PollInterval <= 0 || c.Updates.Lease <= 0 || c.Updates.RetryBackoff <= 0 {
+			return fmt.Errorf("config: updates.poll_interval, updates.lease and updates.retry_backoff must be positive")
+		}
+		if 0 < c.Updates.RetryMaxBackoff && c.Updates.RetryMaxBackoff < c.Updates.RetryBackoff {
+			return fmt.Errorf("config: updates.retry_backoff exceeds updates.retry_max_backoff")
+		}
+		if 0 < c.Updates.Timeout && c.Updates.Lease <= c.Updates.Timeout {
+			return fmt.Errorf("config: updates.lease must exceed updates.timeout")
+		}
+	}
+
+	if c.Events.Relay {
+		if c.Events.Exchange == "" {
+			return fmt.Errorf("config: events.exchange is required")
+		}
+		if c.Events.Batch < 1 {
+			return fmt.Errorf("config: events.batch must be positive")
+		}
+		if c.Events.PollInterval <= 0 || c.Events.Lease <= 0 {
+			return fmt.Errorf("config: events.poll_interval and events.lease must be positive")
+		}
+	}
+
+	if c.Sessions.ActivityInterval < 0 {
+		return fmt.Errorf("config: sessions.activity_interval must not be negative")
+	}
+
+	if c.Sessions.ActivityInterval > 0 {
+		if c.Sessions.ActivityHistory < 1 || c.Sessions.FlushSize < 1 {
+			return fmt.Errorf("config: sessions.activity_history and sessions.flush_size must be positive")
+		}
+		if c.Sessions.FlushInterval <= 0 {
+			return fmt.Errorf("config: sessions.flush_interval must be positive")
+		}
 	}
 
 	if c.Consul.Address == "" {
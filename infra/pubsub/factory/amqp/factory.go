@@ -69,7 +69,7 @@ func (f *Factory) BuildPublisher(pubConfig *factory.PublisherConfig) (message.Pu
 		Connection: amqp.ConnectionConfig{
 			AmqpURI: f.dsn,
 		},
-		Marshaler: amqp.DefaultMarshaler{},
+		Marshaler: Marshaler{},
 		Exchange: amqp.ExchangeConfig{
 			GenerateName: func(s string) string {
 				return pubConfig.Exchange.Name
@@ -81,7 +81,9 @@ func (f *Factory) BuildPublisher(pubConfig *factory.PublisherConfig) (message.Pu
 			GenerateRoutingKey: func(s string) string {
 				return s
 			},
+			ConfirmDelivery: pubConfig.ConfirmDelivery,
 		},
+		TopologyBuilder: &amqp.DefaultTopologyBuilder{},
 	}
 	return amqp.NewPublisher(conf, f.logger)
 }
@@ -14,7 +14,18 @@ type Marshaler struct{}
 var _ amqp.Marshaler = Marshaler{}
 
 func (Marshaler) Marshal(send *message.Message) (amqp091.Publishing, error) {
-	return amqp.DefaultMarshaler{}.Marshal(send)
+	contentType := send.Metadata.Get(".content_type")
+	if contentType != "" {
+		// [ContentType] property ; NOT a header
+		send = send.Copy()
+		delete(send.Metadata, ".content_type")
+	}
+	pub, err := amqp.DefaultMarshaler{}.Marshal(send)
+	if err != nil {
+		return pub, err
+	}
+	pub.ContentType = contentType
+	return pub, nil
 }
 
 func (Marshaler) Unmarshal(recv amqp091.Delivery) (*message.Message, error) {
@@ -30,6 +41,7 @@ func (Marshaler) Unmarshal(recv amqp091.Delivery) (*message.Message, error) {
 
 	msg.Metadata[".exchange"] = recv.Exchange
 	msg.Metadata[".topic"] = recv.RoutingKey
+	msg.Metadata[".content_type"] = recv.ContentType
 
 	for key, value := range recv.Headers {
 		// if key == d.computeMessageUUIDHeaderKey() {
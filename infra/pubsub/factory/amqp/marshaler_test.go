@@ -0,0 +1,41 @@
+package amqp
+
+import (
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	amqp091 "github.com/rabbitmq/amqp091-go"
+)
+
+func TestMarshalerContentType(t *testing.T) {
+
+	send := message.NewMessage("1", []byte("data"))
+	send.Metadata.Set(".content_type", "application/protobuf")
+	send.Metadata.Set("event", "sign_in")
+
+	pub, err := Marshaler{}.Marshal(send)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pub.ContentType != "application/protobuf" {
+		t.Errorf("Marshal().ContentType = %q, want application/protobuf", pub.ContentType)
+	}
+	if _, ok := pub.Headers[".content_type"]; ok {
+		t.Errorf("Marshal().Headers = %v; unexpected .content_type", pub.Headers)
+	}
+	if send.Metadata.Get(".content_type") == "" {
+		t.Errorf("Marshal() modified the source message metadata")
+	}
+
+	recv, err := Marshaler{}.Unmarshal(amqp091.Delivery{
+		ContentType: pub.ContentType,
+		Headers:     pub.Headers,
+		Body:        pub.Body,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recv.Metadata.Get(".content_type") != "application/protobuf" || recv.Metadata.Get("event") != "sign_in" {
+		t.Errorf("Unmarshal().Metadata = %v", recv.Metadata)
+	}
+}
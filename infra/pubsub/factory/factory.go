@@ -37,4 +37,6 @@ type SubscriberConfig struct {
 
 type PublisherConfig struct {
 	Exchange ExchangeConfig
+	// Wait for the broker confirmation of each message published
+	ConfirmDelivery bool
 }
@@ -0,0 +1,203 @@
+package secrets
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Keyring of the [K]ey-[E]ncryption-[K]ey(s).
+//
+// The [active] key wraps NEW data encryption key(s).
+// All the keys are available to unwrap data keys sealed before rotation.
+type Keyring struct {
+	active string
+	keys   map[string]cipher.AEAD
+}
+
+// KeySize of the AES-256 key-encryption key
+const KeySize = 32
+
+// NewKeyring of the AES-256 [keys] by id.
+// The [active] key id MUST be one of the [keys].
+func NewKeyring(active string, keys map[string][]byte) (*Keyring, error) {
+	if active == "" {
+		return nil, errors.New("secrets: active key id required")
+	}
+	ring := &Keyring{
+		active: active,
+		keys:   make(map[string]cipher.AEAD, len(keys)),
+	}
+	for kid, key := range keys {
+		if len(key) != KeySize {
+			return nil, fmt.Errorf("secrets: key( %s ); AES-256 key size %d bytes required", kid, KeySize)
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, fmt.Errorf("secrets: key( %s ); %v", kid, err)
+		}
+		ring.keys[kid] = aead
+	}
+	if _, ok := ring.keys[active]; !ok {
+		return nil, fmt.Errorf("secrets: active key( %s ) not found", active)
+	}
+	return ring, nil
+}
+
+// ParseKeys decodes base64 (std) encoded key(s) from the [text] source.
+//
+// Each line (or comma separated) entry has [kid:]base64 format.
+// Entry without [kid:] prefix is assigned with the [defaultId].
+func ParseKeys(text, defaultId string) (map[string][]byte, error) {
+	keys := make(map[string][]byte)
+	scan := bufio.NewScanner(strings.NewReader(
+		strings.ReplaceAll(text, ",", "\n"),
+	))
+	for scan.Scan() {
+		line := strings.TrimSpace(scan.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		kid, data, ok := strings.Cut(line, ":")
+		if !ok {
+			kid, data = defaultId, line
+		}
+		kid = strings.TrimSpace(kid)
+		if kid == "" {
+			return nil, errors.New("secrets: key id required")
+		}
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(data))
+		if err != nil {
+			return nil, fmt.Errorf("secrets: key( %s ); %v", kid, err)
+		}
+		if _, dup := keys[kid]; dup {
+			return nil, fmt.Errorf("secrets: key( %s ); duplicate", kid)
+		}
+		keys[kid] = key
+	}
+	return keys, scan.Err()
+}
+
+// LoadKeys reads [ParseKeys] source from the [file].
+func LoadKeys(file, defaultId string) (map[string][]byte, error) {
+	text, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("secrets: %v", err)
+	}
+	return ParseKeys(string(text), defaultId)
+}
+
+// KeyId of the active key-encryption key.
+func (c *Keyring) KeyId() string {
+	if c == nil {
+		return ""
+	}
+	return c.active
+}
+
+// Envelope of the encrypted data.
+type Envelope struct {
+	// Key-encryption key ID
+	Kid string `json:"kid"`
+	// Data encryption key, wrapped with the [Kid] key
+	Key []byte `json:"key"`
+	// Data encrypted with the [Key], by name
+	Data map[string][]byte `json:"data"`
+}
+
+// Seal encrypts [data] values with the NEW data key,
+// wrapped with the active key-encryption key.
+// The [aad] binds the envelope to the owner, e.g.: record id.
+func (c *Keyring) Seal(aad []byte, data map[string][]byte) (*Envelope, error) {
+
+	dek := make([]byte, KeySize)
+	if _, err := rand.Read(dek); err != nil {
+		return nil, err
+	}
+	defer clear(dek)
+
+	aead, err := newAEAD(dek)
+	if err != nil {
+		return nil, err
+	}
+
+	kek := c.keys[c.active]
+	env := &Envelope{
+		Kid:  c.active,
+		Key:  seal(kek, dek, []byte(c.active)),
+		Data: make(map[string][]byte, len(data)),
+	}
+
+	for name, plain := range data {
+		env.Data[name] = seal(aead, plain, fieldAAD(aad, name))
+	}
+
+	return env, nil
+}
+
+// Open decrypts the [env]elope data values.
+func (c *Keyring) Open(aad []byte, env *Envelope) (map[string][]byte, error) {
+
+	kek, ok := c.keys[env.Kid]
+	if !ok {
+		return nil, fmt.Errorf("secrets: key( %s ) not found", env.Kid)
+	}
+
+	dek, err := open(kek, env.Key, []byte(env.Kid))
+	if err != nil {
+		return nil, fmt.Errorf("secrets: key( %s ); unwrap: %v", env.Kid, err)
+	}
+	defer clear(dek)
+
+	aead, err := newAEAD(dek)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string][]byte, len(env.Data))
+	for name, sealed := range env.Data {
+		plain, err := open(aead, sealed, fieldAAD(aad, name))
+		if err != nil {
+			return nil, fmt.Errorf("secrets: %s; %v", name, err)
+		}
+		data[name] = plain
+	}
+
+	return data, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func fieldAAD(aad []byte, name string) []byte {
+	return bytes.Join([][]byte{aad, []byte(name)}, []byte{0})
+}
+
+// seal returns nonce || ciphertext
+func seal(aead cipher.AEAD, plain, aad []byte) []byte {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plain)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		panic(err) // crypto/rand never fails
+	}
+	return aead.Seal(nonce, nonce, plain, aad)
+}
+
+func open(aead cipher.AEAD, sealed, aad []byte) ([]byte, error) {
+	size := aead.NonceSize()
+	if len(sealed) < size {
+		return nil, errors.New("ciphertext too short")
+	}
+	return aead.Open(nil, sealed[:size], sealed[size:], aad)
+}
@@ -0,0 +1,53 @@
+package secrets_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/webitel/im-account-service/infra/secrets"
+)
+
+func TestKeyringRotation(t *testing.T) {
+
+	oldKey := make([]byte, secrets.KeySize)
+	newKey := make([]byte, secrets.KeySize)
+	_, _ = rand.Read(oldKey)
+	_, _ = rand.Read(newKey)
+
+	before, err := secrets.NewKeyring("v1", map[string][]byte{"v1": oldKey})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	owner := []byte("client_id")
+	data := map[string][]byte{"service.secret": []byte("s3cr3t")}
+	sealed, err := before.Seal(owner, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(sealed.Data["service.secret"], data["service.secret"]) {
+		t.Fatal("Seal() data is plaintext")
+	}
+
+	after, err := secrets.NewKeyring("v2", map[string][]byte{"v1": oldKey, "v2": newKey})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plain, err := after.Open(owner, sealed)
+	if err != nil {
+		t.Fatalf("Open( kid: v1 ) error = %v", err)
+	}
+	if got := string(plain["service.secret"]); got != "s3cr3t" {
+		t.Errorf("Open() = %q, want %q", got, "s3cr3t")
+	}
+
+	if _, err = after.Open([]byte("other"), sealed); err == nil {
+		t.Error("Open( other owner ) error = nil, want failure")
+	}
+
+	if resealed, _ := after.Seal(owner, plain); resealed.Kid != "v2" {
+		t.Errorf("Seal().Kid = %q, want %q", resealed.Kid, "v2")
+	}
+}
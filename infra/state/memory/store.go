@@ -0,0 +1,149 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/webitel/im-account-service/infra/state"
+)
+
+// Store is an in-process [state.Store] implementation.
+// Suitable for a single service instance ONLY !
+type Store struct {
+	mx     sync.Mutex
+	rate   map[string]entry
+	codes  map[string]entry
+	revoke map[string]entry
+	// janitor
+	stop chan struct{}
+	done chan struct{}
+}
+
+type entry struct {
+	tat   int64  // [rate]: theoretical arrival time
+	value []byte // [codes]: one-time value
+	exp   time.Time
+}
+
+func (e entry) expired(now time.Time) bool {
+	return !e.exp.IsZero() && !now.Before(e.exp)
+}
+
+var _ state.Store = (*Store)(nil)
+
+// New in-memory [Store] that evicts expired keys every [sweep] interval.
+func New(sweep time.Duration) *Store {
+	c := &Store{
+		rate:   make(map[string]entry),
+		codes:  make(map[string]entry),
+		revoke: make(map[string]entry),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	if sweep <= 0 {
+		sweep = time.Minute
+	}
+	go c.janitor(sweep)
+	return c
+}
+
+func (c *Store) janitor(every time.Duration) {
+	defer close(c.done)
+	tick := time.NewTicker(every)
+	defer tick.Stop()
+	for {
+		select {
+		case <-c.stop:
+			return
+		case now := <-tick.C:
+			c.mx.Lock()
+			for _, keys := range []map[string]entry{
+				c.rate, c.codes, c.revoke,
+			} {
+				for key, e := range keys {
+					if e.expired(now) {
+						delete(keys, key)
+					}
+				}
+			}
+			c.mx.Unlock()
+		}
+	}
+}
+
+// Close stops the expired keys eviction.
+func (c *Store) Close() error {
+	select {
+	case <-c.stop:
+	default:
+		close(c.stop)
+	}
+	<-c.done
+	return nil
+}
+
+func (c *Store) Allow(_ context.Context, key string, limit state.Limit, n int) (state.Result, error) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	now := time.Now()
+	e, ok := c.rate[key]
+	if ok && e.expired(now) {
+		e = entry{}
+	}
+	res, tat := state.Bucket(now.UnixMicro(), e.tat, limit, n)
+	if tat > 0 {
+		c.rate[key] = entry{
+			tat: tat, exp: time.UnixMicro(tat),
+		}
+	}
+	return res, nil
+}
+
+func (c *Store) Issue(_ context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	now := time.Now()
+	if e, ok := c.codes[key]; ok && !e.expired(now) {
+		return false, nil
+	}
+	e := entry{value: bytes.Clone(value)}
+	if ttl > 0 {
+		e.exp = now.Add(ttl)
+	}
+	c.codes[key] = e
+	return true, nil
+}
+
+func (c *Store) Consume(_ context.Context, key string) ([]byte, error) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	e, ok := c.codes[key]
+	if !ok {
+		return nil, nil
+	}
+	delete(c.codes, key)
+	if e.expired(time.Now()) {
+		return nil, nil
+	}
+	return e.value, nil
+}
+
+func (c *Store) Revoke(_ context.Context, key string, ttl time.Duration) error {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	e := entry{}
+	if ttl > 0 {
+		e.exp = time.Now().Add(ttl)
+	}
+	c.revoke[key] = e
+	return nil
+}
+
+func (c *Store) Revoked(_ context.Context, key string) (bool, error) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	e, ok := c.revoke[key]
+	return ok && !e.expired(time.Now()), nil
+}
@@ -0,0 +1,50 @@
+package memory_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/webitel/im-account-service/infra/state"
+	"github.com/webitel/im-account-service/infra/state/memory"
+)
+
+func TestStoreAllow(t *testing.T) {
+	store := memory.New(time.Minute)
+	defer store.Close()
+
+	ctx := context.Background()
+	limit := state.Limit{Count: 1, Period: time.Hour, Burst: 3}
+	for i := 0; i < limit.Burst; i++ {
+		res, _ := store.Allow(ctx, "test", limit, 1)
+		if res.Denied() {
+			t.Fatalf("Allow(#%d) denied; want allowed", i+1)
+		}
+		if want := limit.Burst - i - 1; res.Remaining != want {
+			t.Errorf("Allow(#%d).Remaining = %d, want %d", i+1, res.Remaining, want)
+		}
+	}
+	res, _ := store.Allow(ctx, "test", limit, 1)
+	if !res.Denied() || res.RetryAfter <= 0 {
+		t.Errorf("Allow(#%d) = %+v; want denied", limit.Burst+1, res)
+	}
+}
+
+func TestStoreConsume(t *testing.T) {
+	store := memory.New(time.Minute)
+	defer store.Close()
+
+	ctx := context.Background()
+	if ok, _ := store.Issue(ctx, "code", []byte("123456"), time.Minute); !ok {
+		t.Fatal("Issue() = false, want true")
+	}
+	if ok, _ := store.Issue(ctx, "code", []byte("654321"), time.Minute); ok {
+		t.Error("Issue(again) = true, want false")
+	}
+	if got, _ := store.Consume(ctx, "code"); string(got) != "123456" {
+		t.Errorf("Consume() = %q, want %q", got, "123456")
+	}
+	if got, _ := store.Consume(ctx, "code"); got != nil {
+		t.Errorf("Consume(again) = %q, want nil", got)
+	}
+}
@@ -0,0 +1,121 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/webitel/im-account-service/infra/state"
+)
+
+// Keys namespace
+const (
+	prefixRate   = "im:account:rate:"
+	prefixCode   = "im:account:code:"
+	prefixRevoke = "im:account:revoke:"
+)
+
+// Store is a Redis [state.Store] implementation
+// shared by all the service instances.
+type Store struct {
+	client redis.UniversalClient
+}
+
+var _ state.Store = (*Store)(nil)
+
+// New Redis [Store] over the [client] connection.
+func New(client redis.UniversalClient) *Store {
+	return &Store{client: client}
+}
+
+// Client connection.
+func (c *Store) Client() redis.UniversalClient {
+	return c.client
+}
+
+// GCRA token bucket evaluation; See [state.Bucket].
+// Uses the Redis server clock, so all the instances
+// share the same [now] regardless of local clock skew.
+//
+// KEYS[1] - bucket key
+// ARGV[1] - interval (microseconds)
+// ARGV[2] - capacity (burst)
+// ARGV[3] - tokens requested
+//
+// Returns: { allowed, remaining, retry_after(us), reset_after(us) }
+var allowScript = redis.NewScript(`
+local interval = tonumber(ARGV[1])
+local capacity = tonumber(ARGV[2])
+local n = tonumber(ARGV[3])
+
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000000 + tonumber(t[2])
+
+local tolerance = interval * capacity
+local tat = tonumber(redis.call('GET', KEYS[1]) or 0)
+if tat < now then
+	tat = now
+end
+
+local next = tat + interval * n
+local allow_at = next - tolerance
+if allow_at > now then
+	local remaining = math.floor((tolerance - (tat - now)) / interval)
+	if remaining < 0 then
+		remaining = 0
+	end
+	return { 0, remaining, allow_at - now, tat - now }
+end
+
+local reset = next - now
+redis.call('SET', KEYS[1], next, 'PX', math.ceil(reset / 1000))
+return { n, math.floor((tolerance - reset) / interval), 0, reset }
+`)
+
+func (c *Store) Allow(ctx context.Context, key string, limit state.Limit, n int) (res state.Result, err error) {
+	res.Limit = limit
+	if limit.Unlimited() {
+		res, _ = state.Bucket(0, 0, limit, n)
+		return res, nil
+	}
+	if n < 1 {
+		n = 1
+	}
+	vs, err := allowScript.Run(
+		ctx, c.client, []string{prefixRate + key},
+		limit.Interval().Microseconds(), limit.Capacity(), n,
+	).Int64Slice()
+	if err != nil {
+		return res, err
+	}
+	if len(vs) != 4 {
+		return res, errors.New("redis: rate limit script: unexpected result")
+	}
+	res.Allowed = int(vs[0])
+	res.Remaining = int(vs[1])
+	res.RetryAfter = time.Duration(vs[2]) * time.Microsecond
+	res.ResetAfter = time.Duration(vs[3]) * time.Microsecond
+	return res, nil
+}
+
+func (c *Store) Issue(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	return c.client.SetNX(ctx, prefixCode+key, value, ttl).Result()
+}
+
+func (c *Store) Consume(ctx context.Context, key string) ([]byte, error) {
+	data, err := c.client.GetDel(ctx, prefixCode+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	return data, err
+}
+
+func (c *Store) Revoke(ctx context.Context, key string, ttl time.Duration) error {
+	return c.client.Set(ctx, prefixRevoke+key, 1, ttl).Err()
+}
+
+func (c *Store) Revoked(ctx context.Context, key string) (bool, error) {
+	n, err := c.client.Exists(ctx, prefixRevoke+key).Result()
+	return n > 0, err
+}
@@ -0,0 +1,89 @@
+package redis_test
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+
+	"github.com/webitel/im-account-service/infra/state"
+	"github.com/webitel/im-account-service/infra/state/redis"
+)
+
+// newStore connects the [REDIS_ADDR] test server ; skipped if not set.
+func newStore(t *testing.T) (*redis.Store, string) {
+	addr := os.Getenv("REDIS_ADDR")
+	if addr == "" {
+		t.Skip("REDIS_ADDR not set")
+	}
+	client := goredis.NewClient(&goredis.Options{Addr: addr})
+	t.Cleanup(func() { client.Close() })
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		t.Fatalf("redis [%s]: %v", addr, err)
+	}
+	// unique key(s) per run
+	return redis.New(client), t.Name() + ":" + strconv.FormatInt(time.Now().UnixNano(), 36)
+}
+
+func TestStoreAllow(t *testing.T) {
+	store, key := newStore(t)
+
+	ctx := context.Background()
+	limit := state.Limit{Count: 1, Period: time.Hour, Burst: 3}
+	for i := 0; i < limit.Burst; i++ {
+		res, err := store.Allow(ctx, key, limit, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Denied() {
+			t.Fatalf("Allow(#%d) denied; want allowed", i+1)
+		}
+		if want := limit.Burst - i - 1; res.Remaining != want {
+			t.Errorf("Allow(#%d).Remaining = %d, want %d", i+1, res.Remaining, want)
+		}
+	}
+	res, _ := store.Allow(ctx, key, limit, 1)
+	if !res.Denied() || res.RetryAfter <= 0 {
+		t.Errorf("Allow(#%d) = %+v; want denied", limit.Burst+1, res)
+	}
+	// Unlimited ; never stored
+	if res, _ = store.Allow(ctx, key, state.Limit{}, 1); res.Denied() {
+		t.Errorf("Allow(unlimited) = %+v; want allowed", res)
+	}
+}
+
+func TestStoreConsume(t *testing.T) {
+	store, key := newStore(t)
+
+	ctx := context.Background()
+	if ok, _ := store.Issue(ctx, key, []byte("123456"), time.Minute); !ok {
+		t.Fatal("Issue() = false, want true")
+	}
+	if ok, _ := store.Issue(ctx, key, []byte("654321"), time.Minute); ok {
+		t.Error("Issue(again) = true, want false")
+	}
+	if got, _ := store.Consume(ctx, key); string(got) != "123456" {
+		t.Errorf("Consume() = %q, want %q", got, "123456")
+	}
+	if got, _ := store.Consume(ctx, key); got != nil {
+		t.Errorf("Consume(again) = %q, want nil", got)
+	}
+}
+
+func TestStoreRevoke(t *testing.T) {
+	store, key := newStore(t)
+
+	ctx := context.Background()
+	if ok, _ := store.Revoked(ctx, key); ok {
+		t.Fatal("Revoked() = true, want false")
+	}
+	if err := store.Revoke(ctx, key, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := store.Revoked(ctx, key); !ok {
+		t.Error("Revoked() = false, want true")
+	}
+}
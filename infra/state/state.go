@@ -0,0 +1,149 @@
+package state
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Store is a shared (cluster-wide) ephemeral state backend.
+//
+// All keys are namespaced by the implementation,
+// so callers may use plain identifiers, e.g. [app.id]:[ip].
+type Store interface {
+	// Allow takes [n] tokens from the [key] rate-limit bucket.
+	Allow(ctx context.Context, key string, limit Limit, n int) (Result, error)
+	// Issue stores a one-time [value] under [key] for [ttl].
+	// Reports false if the [key] is already issued and not yet consumed.
+	Issue(ctx context.Context, key string, value []byte, ttl time.Duration) (ok bool, err error)
+	// Consume atomically gets and removes the one-time [key] value.
+	// Returns nil, nil if the [key] is absent, expired or already consumed.
+	Consume(ctx context.Context, key string) ([]byte, error)
+	// Revoke marks [key] as revoked for [ttl].
+	Revoke(ctx context.Context, key string, ttl time.Duration) error
+	// Revoked reports whether [key] is marked as revoked.
+	Revoked(ctx context.Context, key string) (bool, error)
+}
+
+// Limit of the token bucket (GCRA) rate.
+// [Count] tokens are refilled every [Period]
+// with up to [Burst] tokens to be taken at once.
+type Limit struct {
+	Count  int
+	Period time.Duration
+	Burst  int
+}
+
+// Interval between two refilled tokens.
+func (r Limit) Interval() time.Duration {
+	if r.Count < 1 || r.Period <= 0 {
+		return 0
+	}
+	return r.Period / time.Duration(r.Count)
+}
+
+// Capacity of the bucket. At least one token.
+func (r Limit) Capacity() int {
+	return max(r.Burst, 1)
+}
+
+// Unlimited reports whether the [Limit] is not set.
+func (r Limit) Unlimited() bool {
+	return r.Interval() <= 0
+}
+
+func (r Limit) String() string {
+	if r.Unlimited() {
+		return "unlimited"
+	}
+	unit := "s"
+	switch r.Period {
+	case time.Minute:
+		unit = "m"
+	case time.Hour:
+		unit = "h"
+	}
+	return strconv.Itoa(r.Count) + "r/" + unit
+}
+
+// ParseLimit parses [rate] spec, e.g.: "10r/s", "30r/m", "1000r/h".
+func ParseLimit(rate string, burst int) (Limit, error) {
+	spec := strings.TrimSpace(rate)
+	count, unit, ok := strings.Cut(spec, "r/")
+	if !ok {
+		return Limit{}, fmt.Errorf("rate %q: invalid format; expect: [N]r/[s|m|h]", rate)
+	}
+	limit := Limit{Burst: burst}
+	switch unit {
+	case "s":
+		limit.Period = time.Second
+	case "m":
+		limit.Period = time.Minute
+	case "h":
+		limit.Period = time.Hour
+	default:
+		return Limit{}, fmt.Errorf("rate %q: unit %q not supported", rate, unit)
+	}
+	n, err := strconv.Atoi(count)
+	if err != nil || n < 1 {
+		return Limit{}, fmt.Errorf("rate %q: invalid count %q", rate, count)
+	}
+	limit.Count = n
+	return limit, nil
+}
+
+// Result of the rate-limit bucket request.
+type Result struct {
+	// Limit requested
+	Limit Limit
+	// Allowed number of tokens taken. Zero if denied.
+	Allowed int
+	// Remaining number of tokens available right now.
+	Remaining int
+	// RetryAfter to wait before the request may be allowed.
+	// Zero if allowed.
+	RetryAfter time.Duration
+	// ResetAfter to wait until the bucket is full again.
+	ResetAfter time.Duration
+}
+
+// Denied reports whether the request was rejected.
+func (r Result) Denied() bool {
+	return r.Allowed < 1
+}
+
+// Bucket is a GCRA (Generic Cell Rate Algorithm) token bucket
+// state evaluation shared by the [Store] implementations.
+//
+// [tat] is the theoretical arrival time stored for the bucket (UnixMicro).
+// Returns the result and new [tat] to be stored; zero if denied.
+func Bucket(now, tat int64, limit Limit, n int) (res Result, next int64) {
+	res.Limit = limit
+	interval := limit.Interval().Microseconds()
+	if interval <= 0 {
+		res.Allowed = n
+		res.Remaining = limit.Capacity()
+		return res, 0
+	}
+	if n < 1 {
+		n = 1
+	}
+	tolerance := interval * int64(limit.Capacity())
+	if tat < now {
+		tat = now
+	}
+	next = tat + interval*int64(n)
+	allowAt := next - tolerance
+	if allowAt > now {
+		res.RetryAfter = time.Duration(allowAt-now) * time.Microsecond
+		res.ResetAfter = time.Duration(tat-now) * time.Microsecond
+		res.Remaining = max(int((tolerance-(tat-now))/interval), 0)
+		return res, 0
+	}
+	res.Allowed = n
+	res.ResetAfter = time.Duration(next-now) * time.Microsecond
+	res.Remaining = int((tolerance - (next - now)) / interval)
+	return res, next
+}
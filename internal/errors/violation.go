@@ -0,0 +1,94 @@
+package errors
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+)
+
+// FieldViolation describes a single bad request field.
+type FieldViolation = errdetails.BadRequest_FieldViolation
+
+// Violations of the request field(s).
+type Violations []*FieldViolation
+
+// Add violation of the [field] with [description].
+func (list *Violations) Add(field, description string, args ...any) {
+	*list = append(*list, &FieldViolation{
+		Field:       field,
+		Description: fmt.Sprintf(description, args...),
+	})
+}
+
+// Err returns (#400) BAD_REQUEST error with
+// the field-level violation(s), if any; nil otherwise.
+func (list Violations) Err(opts ...Option) error {
+	if len(list) == 0 {
+		return nil
+	}
+	return Invalid(list, opts...)
+}
+
+func (list Violations) String() string {
+	var text strings.Builder
+	for i, v := range list {
+		if i > 0 {
+			text.WriteString("; ")
+		}
+		text.WriteString(v.GetField())
+		text.WriteString(": ")
+		text.WriteString(v.GetDescription())
+	}
+	return text.String()
+}
+
+// InvalidError is (#400) BAD_REQUEST error
+// with the field-level violation(s) details.
+type InvalidError struct {
+	err        *Error
+	Violations Violations
+}
+
+// Invalid returns (#400) BAD_REQUEST error with the field-level [violations].
+// Default message enumerates all the [violations].
+//
+//	BadRequest(
+//		Message("%s", violations.String()),
+//		opts...,
+//	)
+func Invalid(violations Violations, opts ...Option) *InvalidError {
+	err := BadRequest(
+		Message("%s", violations.String()),
+	)
+	err.init(opts)
+	return &InvalidError{
+		err:        err,
+		Violations: violations,
+	}
+}
+
+func (err *InvalidError) Error() string {
+	return err.err.Error()
+}
+
+func (err *InvalidError) Unwrap() error {
+	return err.err
+}
+
+// GRPCStatus returns the grpc.Status represented by [err]
+// with google.rpc.BadRequest details of the field-level violation(s).
+func (err *InvalidError) GRPCStatus() *status.Status {
+	top := err.err.GRPCStatus()
+	if len(err.Violations) == 0 {
+		return top
+	}
+	res, re := top.WithDetails(&errdetails.BadRequest{
+		FieldViolations: err.Violations,
+	})
+	if re != nil {
+		return top
+	}
+	return res
+}
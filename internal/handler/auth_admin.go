@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"cmp"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	webitel "github.com/webitel/im-account-service/internal/client/webitel/auth"
+	adpb "github.com/webitel/im-account-service/internal/client/webitel/proto/gen/auth"
+	"github.com/webitel/im-account-service/internal/errors"
+	"github.com/webitel/im-account-service/internal/model"
+)
+
+// Webitel object class(es) of the service (admin) resources
+const (
+	// Applications (configuration) object class
+	ObjclassApps = "im_apps"
+)
+
+// Access mode of the object class operation.
+// Webitel [Objclass.Access] flag.
+type Access byte
+
+const (
+	AccessCreate Access = 'x' // [C]reate ; "add"
+	AccessRead   Access = 'r' // [R]ead   ; "read"
+	AccessWrite  Access = 'w' // [U]pdate ; "write"
+	AccessDelete Access = 'd' // [D]elete ; "delete"
+)
+
+// Permission of the global (any object class) access mode.
+func (mode Access) Permission() string {
+	switch mode {
+	case AccessCreate:
+		return "add"
+	case AccessRead:
+		return "read"
+	case AccessWrite:
+		return "write"
+	case AccessDelete:
+		return "delete"
+	}
+	return ""
+}
+
+func (mode Access) String() string {
+	switch mode {
+	case AccessCreate:
+		return "create"
+	case AccessRead:
+		return "read"
+	case AccessWrite:
+		return "update"
+	case AccessDelete:
+		return "delete"
+	}
+	return string(mode)
+}
+
+// Admin authenticates Webitel (admin) user [X-Webitel-Access] token.
+// Unlike [WebitelAuth.Auth] it does NOT resolve the end-User contact nor session.
+func (x WebitelAuth) Admin(rpc *Context) (*adpb.Userinfo, error) {
+	// [X-Webitel-Access]: [token] ; Authorization
+	bearer := model.GetHeaderH2(
+		rpc.Header, model.H2_X_Access_Token,
+	)
+	if bearer == "" {
+		// No Authorization !
+		return nil, ErrAccountUnauthorized
+	}
+
+	if x.Client == nil {
+		return nil, errors.New(
+			errors.Code(http.StatusServiceUnavailable),
+			errors.Status("UNAVAILABLE"),
+			errors.Message("messaging: webitel authorization unavailable"),
+		)
+	}
+
+	debug, err := x.Client.Inspect(
+		rpc.Context, bearer,
+		webitel.InspectDate(rpc.Date),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if debug.GetDc() < 1 {
+		return nil, ErrAccountUnauthorized
+	}
+
+	return debug, nil
+}
+
+// AdminAuthorization requires [X-Webitel-Access] token of the Webitel user
+// granted with [mode] access to the [objclass] resources.
+// Binds [rpc.Dc] to the user's own domain.
+func AdminAuthorization(objclass string, mode Access) ContextFunc {
+	return func(rpc *Context) error {
+
+		if admin, _ := rpc.Auth.(*adpb.Userinfo); admin == nil {
+			auth := WebitelAuth{rpc.Service.Options().Webitel}
+			debug, err := auth.Admin(rpc)
+			if err != nil {
+				return err
+			}
+			rpc.Dc = debug.GetDc()
+			rpc.Auth = debug
+		}
+
+		admin := rpc.Auth.(*adpb.Userinfo)
+		if !HasAccess(admin, objclass, mode) {
+			return errors.New(
+				errors.Code(http.StatusForbidden),
+				errors.Status("FORBIDDEN"),
+				errors.Message("messaging: access denied; %s( %s ) required", objclass, mode),
+			)
+		}
+
+		// [ OK ]
+		return nil
+	}
+}
+
+// AdminMutation returns the [rpc] operation date
+// with the authorized Webitel admin as the change author.
+func AdminMutation(rpc *Context) model.Mutation {
+	by := model.Mutation{Date: rpc.Date}
+	if admin, _ := rpc.Auth.(*adpb.Userinfo); admin != nil {
+		by.UserId = strconv.FormatInt(admin.GetUserId(), 10)
+		by.UserName = cmp.Or(admin.GetName(), admin.GetUsername())
+	}
+	return by
+}
+
+// HasAccess reports whether the Webitel [user] is granted
+// with [mode] access to the [objclass] resources.
+//
+// Global permission, e.g.: "read", "write", grants access to ANY object class.
+// Otherwise, the [objclass] scope access flags MUST contain the [mode].
+func HasAccess(user *adpb.Userinfo, objclass string, mode Access) bool {
+
+	if user == nil {
+		return false
+	}
+
+	perm := mode.Permission()
+	if perm != "" && slices.ContainsFunc(user.GetPermissions(),
+		func(grant *adpb.Permission) bool {
+			return grant.GetId() == perm
+		},
+	) {
+		return true
+	}
+
+	for _, scope := range user.GetScope() {
+		if scope.GetClass() != objclass {
+			continue
+		}
+		if !scope.GetObac() {
+			// operation-based access control disabled
+			return true
+		}
+		return strings.IndexByte(scope.GetAccess(), byte(mode)) >= 0
+	}
+
+	return false
+}
@@ -0,0 +1,133 @@
+package handler_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	adpb "github.com/webitel/im-account-service/internal/client/webitel/proto/gen/auth"
+	"github.com/webitel/im-account-service/internal/errors"
+	"github.com/webitel/im-account-service/internal/handler"
+)
+
+func TestHasAccess(t *testing.T) {
+
+	var (
+		scope = func(class string, obac bool, access string) *adpb.Userinfo {
+			return &adpb.Userinfo{Dc: 1, Scope: []*adpb.Objclass{
+				{Class: class, Obac: obac, Access: access},
+			}}
+		}
+		grant = func(perm ...string) *adpb.Userinfo {
+			user := &adpb.Userinfo{Dc: 1}
+			for _, id := range perm {
+				user.Permissions = append(user.Permissions, &adpb.Permission{Id: id})
+			}
+			return user
+		}
+	)
+
+	for _, test := range []struct {
+		name string
+		user *adpb.Userinfo
+		mode handler.Access
+		want bool
+	}{
+		{name: "nil", user: nil, mode: handler.AccessRead, want: false},
+		{name: "none", user: &adpb.Userinfo{Dc: 1}, mode: handler.AccessRead, want: false},
+
+		{name: "global/add", user: grant("add"), mode: handler.AccessCreate, want: true},
+		{name: "global/read", user: grant("read"), mode: handler.AccessRead, want: true},
+		{name: "global/write", user: grant("write"), mode: handler.AccessWrite, want: true},
+		{name: "global/delete", user: grant("delete"), mode: handler.AccessDelete, want: true},
+		{name: "global/other", user: grant("read", "playback_record_file"), mode: handler.AccessWrite, want: false},
+
+		{name: "obac/off", user: scope(handler.ObjclassApps, false, ""), mode: handler.AccessDelete, want: true},
+		{name: "obac/create", user: scope(handler.ObjclassApps, true, "x"), mode: handler.AccessCreate, want: true},
+		{name: "obac/read", user: scope(handler.ObjclassApps, true, "r"), mode: handler.AccessRead, want: true},
+		{name: "obac/write", user: scope(handler.ObjclassApps, true, "w"), mode: handler.AccessWrite, want: true},
+		{name: "obac/delete", user: scope(handler.ObjclassApps, true, "d"), mode: handler.AccessDelete, want: true},
+		{name: "obac/all", user: scope(handler.ObjclassApps, true, "xrwd"), mode: handler.AccessWrite, want: true},
+		{name: "obac/deny/create", user: scope(handler.ObjclassApps, true, "rwd"), mode: handler.AccessCreate, want: false},
+		{name: "obac/deny/read", user: scope(handler.ObjclassApps, true, "xwd"), mode: handler.AccessRead, want: false},
+		{name: "obac/deny/write", user: scope(handler.ObjclassApps, true, "xrd"), mode: handler.AccessWrite, want: false},
+		{name: "obac/deny/delete", user: scope(handler.ObjclassApps, true, "xrw"), mode: handler.AccessDelete, want: false},
+
+		{name: "objclass/missing", user: scope("contacts", true, "xrwd"), mode: handler.AccessRead, want: false},
+		{name: "objclass/missing/obac_off", user: scope("contacts", false, ""), mode: handler.AccessRead, want: false},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if got := handler.HasAccess(test.user, handler.ObjclassApps, test.mode); got != test.want {
+				t.Errorf("HasAccess(%s) = %t, want %t", test.mode, got, test.want)
+			}
+		})
+	}
+}
+
+func TestAdminAuthorization(t *testing.T) {
+
+	srv, _ := handler.NewService(handler.ServiceOptions{
+		Logger: slog.New(slog.DiscardHandler),
+	})
+
+	for _, test := range []struct {
+		name string
+		user *adpb.Userinfo // authenticated ; nil: none
+		mode handler.Access
+
+		code   int32 // error ; zero: none
+		status string
+	}{
+		{
+			name: "global",
+			user: &adpb.Userinfo{Dc: 1, Permissions: []*adpb.Permission{{Id: "write"}}},
+			mode: handler.AccessWrite,
+		},
+		{
+			name: "obac",
+			user: &adpb.Userinfo{Dc: 1, Scope: []*adpb.Objclass{{Class: handler.ObjclassApps, Obac: true, Access: "r"}}},
+			mode: handler.AccessRead,
+		},
+		{
+			name: "deny",
+			user: &adpb.Userinfo{Dc: 1, Scope: []*adpb.Objclass{{Class: handler.ObjclassApps, Obac: true, Access: "r"}}},
+			mode: handler.AccessDelete,
+			code: 403, status: "FORBIDDEN",
+		},
+		{
+			name: "objclass/missing",
+			user: &adpb.Userinfo{Dc: 1, Scope: []*adpb.Objclass{{Class: "contacts", Obac: true, Access: "xrwd"}}},
+			mode: handler.AccessRead,
+			code: 403, status: "FORBIDDEN",
+		},
+		{
+			name: "unauthorized",
+			user: nil, // no [X-Webitel-Access] token
+			mode: handler.AccessRead,
+			code: 401, status: "UNAUTHORIZED",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			rpc := &handler.Context{
+				Context: context.Background(),
+				Service: srv,
+			}
+			if test.user != nil {
+				rpc.Auth = test.user
+			}
+
+			err := handler.AdminAuthorization(handler.ObjclassApps, test.mode)(rpc)
+
+			if test.code == 0 {
+				if err != nil {
+					t.Fatalf("AdminAuthorization(%s) error = %v, want none", test.mode, err)
+				}
+				return
+			}
+			re, _ := errors.FromError(err)
+			if re.Proto().GetCode() != test.code || re.Proto().GetStatus() != test.status {
+				t.Fatalf("AdminAuthorization(%s) error = %v, want (#%d) %s", test.mode, err, test.code, test.status)
+			}
+		})
+	}
+}
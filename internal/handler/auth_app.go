@@ -2,6 +2,7 @@ package handler
 
 import (
 	"github.com/webitel/im-account-service/internal/model"
+	"github.com/webitel/im-account-service/internal/store"
 )
 
 // [X-Webitel-Client] credentials
@@ -25,7 +26,11 @@ func AppAuthorization(require bool) ContextFunc {
 			return ErrClientRequired
 		}
 
-		// TODO: AuthorizeApp(!)
+		// Revoked ?
+		if err = app.Authorize(); err != nil {
+			return err
+		}
+
 		rpc.Dc = app.GetDc()
 		rpc.App = app
 
@@ -41,6 +46,45 @@ func AppAuthorization(require bool) ContextFunc {
 	}
 }
 
+// [client_secret] credentials of the authorized [X-Webitel-Client] App.
+// Required, if the App has any active secret(s) issued ; optional otherwise.
+// Given [clientId], if any, MUST match the App authorization.
+func ClientSecretAuthorization(clientId, secret string) ContextFunc {
+	return func(rpc *Context) error {
+
+		app := rpc.App
+		if app == nil {
+			if clientId != "" || secret != "" {
+				return ErrClientRequired
+			}
+			return nil
+		}
+
+		if clientId != "" && clientId != app.ClientId() {
+			return ErrClientUnauthorized
+		}
+
+		check, err := rpc.Service.Options().Secrets.Verify(
+			store.VerifyAppSecretRequest{
+				Context: rpc.Context,
+				AppId:   app.ClientId(),
+				Secret:  secret,
+				Date:    rpc.Date,
+			},
+		)
+
+		if err != nil {
+			return err
+		}
+
+		if check.Match == nil && (check.Required || secret != "") {
+			return model.ErrClientSecret
+		}
+
+		return nil
+	}
+}
+
 // [X-Webitel-Client] ; Get Application authorization credentials
 func GetApplication(rpc *Context) (*model.Application, error) {
 
@@ -181,6 +181,10 @@ func AuthorizeSession(rpc *Context, session *model.Authorization) error {
 				errors.Message("messaging: application not authorized"),
 			)
 		}
+		// Revoked ?
+		if err = app.Authorize(); err != nil {
+			return err
+		}
 	}
 	// expose latest known session device registration
 	if device == nil { // && session.Device.Id != "" {
@@ -233,6 +237,9 @@ func AuthorizeSession(rpc *Context, session *model.Authorization) error {
 	// assign resolved session contact
 	rpc.Contact = contact
 
+	// record session activity, if due
+	rpc.Service.TouchSession(rpc.Context, session, device, rpc.Date)
+
 	// [ OK ]
 	return nil
 }
@@ -116,6 +116,36 @@ func (srv *Service) GetContact(ctx context.Context, lookup ...ContactSearchOptio
 	return &res, nil
 }
 
+// ContactLocales returns the [ids] contact(s) locale ; [contact.id]
+// Contact(s) with no locale omitted.
+func (srv *Service) ContactLocales(ctx context.Context, dc int64, ids []string) (map[string]string, error) {
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	list, err := srv.opts.Contacts.SearchContact(ctx, &impb.SearchContactRequest{
+		DomainId: int32(dc),
+		Ids:      ids,
+		Page:     1,
+		Size:     int32(len(ids)),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]string, len(ids))
+	for _, src := range list.GetContacts() {
+		var contact model.Contact
+		if contactFromProtoV1(src, &contact) && contact.Locale != "" {
+			res[contact.Id] = contact.Locale
+		}
+	}
+
+	return res, nil
+}
+
 func (srv *Service) AddContact(ctx context.Context, set *model.Contact) error {
 	// TODO: Client.Service("im-contact-service").SaveContact(set)
 	repo := srv.opts.Contacts
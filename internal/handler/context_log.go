@@ -75,12 +75,9 @@ func (x *ctxLogValue) Attrs() (attrs []slog.Attr) {
 				"client.name", name,
 			))
 		}
-		if device.Push.GetToken() != nil {
-			push := device.Push.ProtoReflect()
+		if len(device.Push) > 0 {
 			attrs = append(attrs, slog.String(
-				"client.push", string(push.WhichOneof(
-					push.Descriptor().Oneofs().ByName("token"),
-				).Name()),
+				"client.push", device.Push.String(),
 			))
 		}
 	}
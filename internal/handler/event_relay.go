@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/webitel/im-account-service/infra/pubsub/factory"
+	"github.com/webitel/im-account-service/internal/model"
+	"github.com/webitel/im-account-service/internal/push/dispatch"
+	"github.com/webitel/im-account-service/internal/store"
+	eventpb "github.com/webitel/im-account-service/proto/gen/im/event/account/v1"
+	"go.uber.org/fx"
+	"google.golang.org/protobuf/proto"
+)
+
+// Account event message header(s)
+const (
+	// Event schema ; full protobuf message name
+	eventSchemaHeader = "schema"
+	// Event.Data.(type)
+	eventTypeHeader = "event"
+)
+
+// AccountEventRoutingKey returns the broker routing key of the [dc] event [typeOf] ;
+// account.<dc>.<type>
+func AccountEventRoutingKey(dc int64, typeOf string) string {
+	return "account." + strconv.FormatInt(dc, 10) + "." + typeOf
+}
+
+// StartEventRelay runs the account domain event(s) relay, if enabled.
+// Publishes the event(s) recorded by the session change transaction(s)
+// to the broker topic exchange ; See [AccountEventRoutingKey].
+func StartEventRelay(srv *Service, runtime fx.Lifecycle) {
+
+	policy := srv.opts.EventRelay
+	broker := srv.opts.Broker
+	if !policy.Enabled || broker == nil {
+		return // disabled
+	}
+
+	var publisher message.Publisher
+	run := &outboxRunner[*model.AccountEvent]{
+		Name:         "EVENT",
+		Logger:       srv.opts.Logger,
+		Workers:      1, // keep order
+		Batch:        policy.Batch,
+		PollInterval: policy.PollInterval,
+		Claim: func(ctx context.Context, size int) ([]*model.AccountEvent, error) {
+			return srv.opts.Events.Claim(
+				store.ClaimEventRequest{
+					Context: ctx,
+					Lease:   policy.Lease,
+					Size:    size,
+				},
+			)
+		},
+		Deliver: func(ctx context.Context, list []*model.AccountEvent) {
+			srv.relayEvents(ctx, publisher, list)
+		},
+		OnStart: func(context.Context) (err error) {
+			publisher, err = broker.GetFactory().BuildPublisher(
+				&factory.PublisherConfig{
+					Exchange: factory.ExchangeConfig{
+						Name:    policy.Exchange,
+						Type:    "topic",
+						Durable: true,
+					},
+					ConfirmDelivery: true,
+				},
+			)
+			return err
+		},
+		OnStop: func(context.Context) error {
+			return publisher.Close()
+		},
+	}
+
+	run.Start(runtime)
+}
+
+// relayEvents publishes the claimed [list] event(s) in order.
+// On failure, the rest of the batch is re-claimed after the lease.
+func (srv *Service) relayEvents(ctx context.Context, publisher message.Publisher, list []*model.AccountEvent) {
+
+	var done []int64
+	for _, rec := range list {
+		send, err := AccountEventMessage(rec)
+		if err != nil {
+			// Malformed ; drop, do NOT block the session event(s) !
+			srv.opts.Logger.Error(
+				"[ EVENT ] invalid record; "+err.Error(),
+				"id", rec.Id, "type", rec.Type,
+			)
+			done = append(done, rec.Id)
+			continue
+		}
+		err = publisher.Publish(AccountEventRoutingKey(rec.Dc, rec.Type), send)
+		if err != nil {
+			srv.opts.Logger.Error(
+				"[ EVENT ] publish; "+err.Error(),
+				"id", rec.Id, "type", rec.Type, "attempts", rec.Attempts,
+			)
+			break // keep order ; retry after lease
+		}
+		done = append(done, rec.Id)
+	}
+
+	// Bookkeeping ; even on shutdown
+	ctx = context.WithoutCancel(ctx)
+	if err := srv.opts.Events.Delete(ctx, done); err != nil {
+		// Published ; duplicate(s) after lease
+		srv.opts.Logger.Error("[ EVENT ] outbox delete; " + err.Error())
+	}
+
+	srv.opts.Logger.Debug(
+		"[ EVENT ] outbox batch",
+		"claimed", len(list),
+		"published", len(done),
+	)
+}
+
+// AccountEventProto returns the [src] record broker event.
+func AccountEventProto(src *model.AccountEvent) (*eventpb.Event, error) {
+
+	res := &eventpb.Event{
+		Id:   src.Id,
+		Dc:   src.Dc,
+		Type: src.Type,
+		Date: src.CreatedAt.UnixMilli(),
+		Session: &eventpb.Session{
+			Id:        src.SessionId,
+			AppId:     src.AppId,
+			Name:      src.Name,
+			DeviceId:  src.DeviceId,
+			Ip:        src.IP,
+			UserAgent: src.UserAgent,
+		},
+	}
+
+	if uid := src.Contact; !uid.IsZero() {
+		res.Session.Contact = &eventpb.Contact{
+			Dc:  uid.Dc,
+			Id:  uid.Id,
+			Iss: uid.Iss,
+			Sub: uid.Sub,
+		}
+	}
+
+	provider, _ := dispatch.Token(src.Push)
+	switch src.Type {
+	case model.AccountSignIn:
+		res.Data = &eventpb.Event_SignIn{SignIn: &eventpb.SignIn{}}
+	case model.AccountSignOut:
+		res.Data = &eventpb.Event_SignOut{SignOut: &eventpb.SignOut{}}
+	case model.AccountSessionRevoked:
+		res.Data = &eventpb.Event_SessionRevoked{
+			SessionRevoked: &eventpb.SessionRevoked{Reason: src.Reason},
+		}
+	case model.AccountSessionUpdated:
+		res.Data = &eventpb.Event_SessionUpdated{SessionUpdated: &eventpb.SessionUpdated{}}
+	case model.AccountPushRegistered:
+		res.Data = &eventpb.Event_PushRegistered{
+			PushRegistered: &eventpb.PushRegistered{Type: src.PushType, Provider: provider},
+		}
+	case model.AccountPushUnregistered:
+		res.Data = &eventpb.Event_PushUnregistered{
+			PushUnregistered: &eventpb.PushUnregistered{Type: src.PushType, Provider: provider},
+		}
+	default:
+		return nil, fmt.Errorf("event: type %q unknown", src.Type)
+	}
+
+	return res, nil
+}
+
+// AccountEventMessage returns the [src] record broker message ; application/protobuf.
+func AccountEventMessage(src *model.AccountEvent) (*message.Message, error) {
+
+	event, err := AccountEventProto(src)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	send := message.NewMessage(strconv.FormatInt(src.Id, 10), data)
+	send.Metadata.Set(".content_type", "application/protobuf")
+	send.Metadata.Set(eventSchemaHeader, string(proto.MessageName(event)))
+	send.Metadata.Set(eventTypeHeader, src.Type)
+
+	return send, nil
+}
@@ -3,8 +3,10 @@ package v1
 import (
 	"cmp"
 	"context"
+	"crypto/ecdh"
 	"log/slog"
 	"net"
+	"net/url"
 
 	"github.com/google/uuid"
 	// v1 "github.com/webitel/im-account-service/gen/auth/v1"
@@ -13,7 +15,9 @@ import (
 	"github.com/webitel/im-account-service/internal/errors"
 	"github.com/webitel/im-account-service/internal/handler"
 	"github.com/webitel/im-account-service/internal/model"
+	"github.com/webitel/im-account-service/internal/push/dispatch"
 	"github.com/webitel/im-account-service/internal/store"
+	clientpb "github.com/webitel/im-account-service/proto/gen/im/client/v1"
 	adminpb "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
 	v1 "github.com/webitel/im-account-service/proto/gen/im/service/auth/v1"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -105,7 +109,8 @@ func (api *AccountService) Token(ctx context.Context, req *v1.TokenRequest) (*v1
 						Bot:       session.Device.App.Bot,
 						String_:   session.Device.App.String,
 					},
-					Push: session.Device.Push, // session.Device.Push.GetToken() != nil,
+					Push:   session.Device.Push.Primary(), // session.Device.Push.GetToken() != nil,
+					Pushes: session.Device.Push.List(),
 				},
 				// Contact: &v1.Identity{
 				// 	Iss:                 contact.Iss,
@@ -233,6 +238,16 @@ func (api *AccountService) Logout(ctx context.Context, req *v1.LogoutRequest) (*
 		// something went wrong
 		return nil, err
 	}
+
+	api.srv.SendSessionUpdate(
+		rpc.Context, rpc.App, session, &clientpb.Update{
+			Data: &clientpb.Update_SignOut{
+				SignOut: &clientpb.SignOut{
+					Session: model.UpdateSession(session),
+				},
+			},
+		},
+	)
 	// [ OK ]
 	return &v1.LogoutResponse{}, nil
 
@@ -346,6 +361,13 @@ func (api *AccountService) RegisterDevice(ctx context.Context, req *v1.RegisterD
 			errors.Message("register: PUSH.(token) required"),
 		)
 	}
+
+	if req.Push.GetType() == v1.PUSHSubscription_VOIP && req.Push.GetWeb() != nil {
+		return nil, errors.BadRequest(
+			errors.Status("BAD_PUSH_TYPE"),
+			errors.Message("register: PUSH.type; VOIP registration of the web token not supported"),
+		)
+	}
 	// endregion: Request Validation
 
 	// JWT      ; (external: App)             ; NO (internal) session to attach PUSH token  =((
@@ -401,7 +423,32 @@ func (api *AccountService) RegisterDevice(ctx context.Context, req *v1.RegisterD
 		}
 	case *v1.PUSHSubscription_Web:
 		{
-			// TODO
+			if service.GetWeb() == nil {
+				// no client configuration == no support
+				return nil, errors.BadRequest(
+					errors.Status("NO_WEBPUSH_SERVICE"),
+					errors.Message("register: no [app.service.push.web] client configuration"),
+				)
+			}
+			sub := req.Push.GetWeb()
+			if _, err := ecdh.P256().NewPublicKey(sub.GetKey().GetP256Dh()); err != nil {
+				return nil, errors.BadRequest(
+					errors.Status("BAD_WEBPUSH_KEY"),
+					errors.Message("register: PUSH.web.key.p256dh; P-256 public key required"),
+				)
+			}
+			if len(sub.GetKey().GetAuth()) != 16 {
+				return nil, errors.BadRequest(
+					errors.Status("BAD_WEBPUSH_KEY"),
+					errors.Message("register: PUSH.web.key.auth; 16 bytes secret required"),
+				)
+			}
+			if link, err := url.Parse(sub.GetEndpoint()); err != nil || link.Scheme != "https" || link.Host == "" {
+				return nil, errors.BadRequest(
+					errors.Status("BAD_WEBPUSH_ENDPOINT"),
+					errors.Message("register: PUSH.web.endpoint; https:// URL required"),
+				)
+			}
 		}
 	default:
 	}
@@ -411,10 +458,14 @@ func (api *AccountService) RegisterDevice(ctx context.Context, req *v1.RegisterD
 	// err = repo.RegisterDevice(
 	// 	rpc.Context, rpc.Session.Id, req.Push,
 	// )
+	otherUids, err := otherUidsInput(req.GetOtherUids())
+	if err != nil {
+		return nil, err
+	}
 	err = repo.RegisterDevice(store.RegisterDeviceRequest{
 		Context:       rpc.Context,
 		Authorization: *rpc.Session,
-		OtherUids:     nil,
+		OtherUids:     otherUids,
 		Token:         req.Push,
 	})
 
@@ -422,6 +473,19 @@ func (api *AccountService) RegisterDevice(ctx context.Context, req *v1.RegisterD
 		return nil, err
 	}
 
+	provider, _ := dispatch.Token(req.Push)
+	api.srv.SendSessionUpdate(
+		rpc.Context, rpc.App, rpc.Session, &clientpb.Update{
+			Data: &clientpb.Update_DeviceRegistered{
+				DeviceRegistered: &clientpb.DeviceRegistered{
+					Session:  model.UpdateSession(rpc.Session),
+					Type:     model.PushType(req.Push),
+					Provider: provider,
+				},
+			},
+		},
+	)
+
 	return &v1.RegisterDeviceResponse{}, nil
 	// return api.UnimplementedAccountServer.RegisterDevice(ctx, req)
 }
@@ -497,10 +561,14 @@ func (api *AccountService) UnregisterDevice(ctx context.Context, req *v1.Unregis
 
 	// PERFORM: deregister for current session
 	repo := api.srv.Options().Sessions
+	otherUids, err := otherUidsInput(req.GetOtherUids())
+	if err != nil {
+		return nil, err
+	}
 	err = repo.UnregisterDevice(store.UnregisterDeviceRequest{
 		Context:   rpc.Context,
 		SessionId: rpc.Session.Id,
-		OtherUids: nil,
+		OtherUids: otherUids,
 		Token:     req.Push,
 	})
 
@@ -508,10 +576,52 @@ func (api *AccountService) UnregisterDevice(ctx context.Context, req *v1.Unregis
 		return nil, err
 	}
 
+	provider, _ := dispatch.Token(req.Push)
+	api.srv.SendSessionUpdate(
+		rpc.Context, rpc.App, rpc.Session, &clientpb.Update{
+			Data: &clientpb.Update_DeviceUnregistered{
+				DeviceUnregistered: &clientpb.DeviceUnregistered{
+					Session:  model.UpdateSession(rpc.Session),
+					Type:     model.PushType(req.Push),
+					Provider: provider,
+				},
+			},
+		},
+	)
+
 	return &v1.UnregisterDeviceResponse{}, nil
 	// return api.UnimplementedAccountServer.UnregisterDevice(ctx, req)
 }
 
+// Maximum number of the [other_uids] per device
+const maxOtherUids = 32
+
+// otherUidsInput returns the other signed-in contact(s) of the device [input].
+func otherUidsInput(input []*v1.InputContact) ([]*model.ContactId, error) {
+	if len(input) > maxOtherUids {
+		return nil, errors.BadRequest(
+			errors.Message("device: other_uids; too many ; max %d", maxOtherUids),
+		)
+	}
+	var list []*model.ContactId
+	for i, contact := range input {
+		var uid *model.ContactId
+		switch e := contact.GetInput().(type) {
+		case *v1.InputContact_Id:
+			uid = &model.ContactId{Id: e.Id}
+		case *v1.InputContact_Source:
+			uid = &model.ContactId{Iss: e.Source.GetIss(), Sub: e.Source.GetSub()}
+		}
+		if !uid.IsValid() {
+			return nil, errors.BadRequest(
+				errors.Message("device: other_uids[%d]; contact( id | source{iss,sub} ) required", i),
+			)
+		}
+		list = append(list, uid)
+	}
+	return list, nil
+}
+
 // // Get logged-in session(s)
 // // https://core.telegram.org/method/account.getAuthorizations
 // func (api *AccountService) GetSessions(ctx context.Context, req *v1.GetSessionRequest) (*v1.SessionList, error) {
@@ -613,6 +723,17 @@ func netIPstring(ip net.IP) string {
 	return ip.String()
 }
 
+func netIPstrings(list []net.IP) []string {
+	if len(list) == 0 {
+		return nil
+	}
+	res := make([]string, 0, len(list))
+	for _, ip := range list {
+		res = append(res, ip.String())
+	}
+	return res
+}
+
 func currentAuthorizationProtoV1(rpc *handler.Context) (*v1.Authorization, error) {
 
 	authN := &v1.Authorization{
@@ -625,14 +746,17 @@ func currentAuthorizationProtoV1(rpc *handler.Context) (*v1.Authorization, error
 		authN.Dc = session.Dc
 		authN.Id = session.Id
 		authN.Date = model.Timestamp.Time(session.Date)
+		authN.Active = model.Timestamp.Time(session.Active)
 		authN.Name = session.Name
 		authN.AppId = session.AppId
 
 		device := &session.Device
 		authN.Device = &v1.Device{
-			Id:   device.Id,
-			Ip:   netIPstring(device.IP()),
-			Push: device.Push,
+			Id:     device.Id,
+			Ip:     netIPstring(device.IP()),
+			Push:   device.Push.Primary(),
+			Pushes: device.Push.List(),
+			From:   netIPstrings(device.From),
 		}
 
 		if agent := &device.App; agent.String != "" {
@@ -668,10 +792,16 @@ func currentAuthorizationProtoV1(rpc *handler.Context) (*v1.Authorization, error
 	// current (latest) device from request
 	if device := rpc.Device; device != nil {
 
+		pushes := device.Push.List()
+		if len(pushes) == 0 {
+			pushes = authN.Device.GetPushes()
+		}
+
 		authN.Device = &v1.Device{
-			Id:   cmp.Or(device.Id, authN.Device.GetId()),
-			Ip:   netIPstring(device.IP()),
-			Push: cmp.Or(device.Push, authN.Device.GetPush()),
+			Id:     cmp.Or(device.Id, authN.Device.GetId()),
+			Ip:     netIPstring(device.IP()),
+			Push:   cmp.Or(device.Push.Primary(), authN.Device.GetPush()),
+			Pushes: pushes,
 		}
 
 		if agent := &device.App; agent.String != "" {
@@ -761,6 +891,7 @@ func authorizationFormProtoV1(src *model.Authorization) *v1.Authorization {
 		Dc:      src.Dc,
 		Id:      src.Id,
 		Date:    model.Timestamp.Time(src.Date),
+		Active:  model.Timestamp.Time(src.Active),
 		Name:    src.Name,
 		AppId:   src.AppId,
 		Device:  nil, // &v1.Device{},
@@ -776,10 +907,17 @@ func authorizationFormProtoV1(src *model.Authorization) *v1.Authorization {
 	// dst.AppId = src.AppId
 
 	device := &src.Device
+	// last activity address ; otherwise: signed in from
+	ip := device.IP()
+	if len(ip) == 0 {
+		ip = src.IP
+	}
 	dst.Device = &v1.Device{
-		Id:   device.Id,
-		Ip:   netIPstring(device.IP()),
-		Push: device.Push,
+		Id:     device.Id,
+		Ip:     netIPstring(ip),
+		Push:   device.Push.Primary(),
+		Pushes: device.Push.List(),
+		From:   netIPstrings(device.From),
 	}
 
 	if agent := &device.App; agent.String != "" {
@@ -929,6 +1067,8 @@ func (api *AccountService) GrantTokenForUserIdentity(ctx context.Context, req *v
 		ctx,
 		// [X-Webitel-Client] ; REQUIRED
 		handler.AppAuthorization(true),
+		// [client_secret] ; REQUIRED -if- App has one issued
+		handler.ClientSecretAuthorization(req.GetClientId(), req.GetClientSecret()),
 		// [X-Webitel-Device] ; REQUIRED
 		handler.DeviceAuthorization(true),
 		// [X-Webitel-Access] ; OPTIONAL
@@ -950,6 +1090,19 @@ func (api *AccountService) GrantTokenForUserIdentity(ctx context.Context, req *v
 		return rpc, err
 	}
 
+	// App backend approval ; [service.login_hook]
+	patched, err := api.srv.LoginHook(rpc.Context, rpc.App, rpc.Device, contact)
+	if err != nil {
+		return rpc, err
+	}
+	if patched {
+		// Patched profile MUST meet the App constraints as well
+		err = rpc.App.NewIdentity(contact)
+		if err != nil {
+			return rpc, err
+		}
+	}
+
 	// contact.Dc = rpc.App.GetDc()
 	// Validate Contact.Iss VIA client App used to login ...
 	// err = rpc.App.NewContact(contact)
@@ -961,6 +1114,15 @@ func (api *AccountService) GrantTokenForUserIdentity(ctx context.Context, req *v
 		return rpc, err
 	}
 
+	api.srv.SendUpdate(
+		rpc.Context, rpc.App, &clientpb.Update{
+			Contact: handler.UpdateContactProfile(contact),
+			Data: &clientpb.Update_ContactUpserted{
+				ContactUpserted: &clientpb.ContactUpserted{},
+			},
+		},
+	)
+
 	// // Authorize Contact for Login
 	// rpc.Contact = profile
 
@@ -1064,13 +1226,8 @@ func (api *AccountService) GrantTokenForUserIdentity(ctx context.Context, req *v
 			rpc.Context, (slog.LevelInfo + 1),
 			"[ Authorization ] NEW Session", // NEW Device
 			"session", slogx.DeferValue(func() slog.Value {
-				var pushVia string // PUSH: service [VIA] kind ; not registered
-				if regpush := session.Device.Push; regpush.GetToken() != nil {
-					protom := session.Device.Push.ProtoReflect()
-					pushVia = string(protom.WhichOneof(
-						protom.Descriptor().Oneofs().ByName("token"),
-					).Name())
-				}
+				// PUSH: [type:]service [VIA] kind(s) ; not registered
+				pushVia := session.Device.Push.String()
 				return slog.GroupValue(
 					slog.Int64("dc", session.Dc),
 					slog.String("id", session.Id),
@@ -1089,8 +1246,13 @@ func (api *AccountService) GrantTokenForUserIdentity(ctx context.Context, req *v
 		)
 	}
 
+	// App [service.send_update] event(s) ; emitted once stored
+	signedIn := (hint == nil)
+	var revoked *model.ContactId
+
 	signOut := session.Contact
 	if !signIn.Equal(signOut) {
+		signedIn, revoked = true, signOut
 		if signOut != nil {
 			rpc.Info(
 				"[ Authorization ] Sign-OUT", append(trace,
@@ -1179,13 +1341,8 @@ func (api *AccountService) GrantTokenForUserIdentity(ctx context.Context, req *v
 			// "NEW Token [RE]Generation",
 			"[ Authorization ] NEW Token",
 			"session", slogx.DeferValue(func() slog.Value {
-				var pushVia string // PUSH: service [VIA] kind ; not registered
-				if regpush := session.Device.Push; regpush.GetToken() != nil {
-					protom := session.Device.Push.ProtoReflect()
-					pushVia = string(protom.WhichOneof(
-						protom.Descriptor().Oneofs().ByName("token"),
-					).Name())
-				}
+				// PUSH: [type:]service [VIA] kind(s) ; not registered
+				pushVia := session.Device.Push.String()
 				return slog.GroupValue(
 					slog.Int64("dc", session.Dc),
 					slog.String("id", session.Id),
@@ -1223,6 +1380,32 @@ func (api *AccountService) GrantTokenForUserIdentity(ctx context.Context, req *v
 		return rpc, err
 	}
 
+	if revoked != nil {
+		rpc.Service.SendSessionUpdate(
+			rpc.Context, rpc.App, session, &clientpb.Update{
+				Contact: model.UpdateContact(revoked),
+				Data: &clientpb.Update_SessionRevoked{
+					SessionRevoked: &clientpb.SessionRevoked{
+						Session: model.UpdateSession(session),
+						Reason:  model.UpdateSignIn,
+					},
+				},
+			},
+		)
+	}
+	if signedIn {
+		rpc.Service.SendSessionUpdate(
+			rpc.Context, rpc.App, session, &clientpb.Update{
+				Contact: handler.UpdateContactProfile(contact),
+				Data: &clientpb.Update_SignIn{
+					SignIn: &clientpb.SignIn{
+						Session: model.UpdateSession(session),
+					},
+				},
+			},
+		)
+	}
+
 	// Authorize contact & session + grant
 	rpc.Contact = contact
 	rpc.Session = session
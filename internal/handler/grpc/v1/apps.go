@@ -3,24 +3,32 @@ package v1
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	grpcsrv "github.com/webitel/im-account-service/infra/server/grpc"
+	"github.com/webitel/im-account-service/internal/errors"
+	"github.com/webitel/im-account-service/internal/handler"
 	"github.com/webitel/im-account-service/internal/model"
+	"github.com/webitel/im-account-service/internal/push"
+	"github.com/webitel/im-account-service/internal/push/dispatch"
 	"github.com/webitel/im-account-service/internal/store"
 	impb "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 type ApplicationService struct {
 	impb.UnimplementedApplicationsServer
 
+	srv    *handler.Service
 	store  store.AppStore
 	logger *slog.Logger
 }
 
 var _ impb.ApplicationsServer = (*ApplicationService)(nil)
 
-func NewApplicationService(storage store.AppStore, logger *slog.Logger) *ApplicationService {
-	return &ApplicationService{store: storage, logger: logger}
+func NewApplicationService(handler *handler.Service, storage store.AppStore, logger *slog.Logger) *ApplicationService {
+	return &ApplicationService{srv: handler, store: storage, logger: logger}
 }
 
 func RegisterApplicationService(server *grpcsrv.Server, handler *ApplicationService) {
@@ -31,14 +39,47 @@ func RegisterApplicationService(server *grpcsrv.Server, handler *ApplicationServ
 
 // func (c *ApplicationService) mustEmbedUnimplementedApplicationsServer() {}
 
+// authorize Webitel [admin] request for [mode] access to the Application(s).
+// Result [rpc.Dc] is bound to the admin's own domain.
+func (c *ApplicationService) authorize(ctx context.Context, mode handler.Access) (*handler.Context, error) {
+	return c.srv.GetContext(
+		ctx, handler.AdminAuthorization(
+			handler.ObjclassApps, mode,
+		),
+	)
+}
+
 // Get Application(s) list
 func (c *ApplicationService) SearchApps(ctx context.Context, req *impb.SearchAppRequest) (*impb.ApplicationList, error) {
 	// return c.UnimplementedApplicationsServer.SearchApps(ctx, req)
 
+	rpc, err := c.authorize(ctx, handler.AccessRead)
+	if err != nil {
+		return nil, err
+	}
+
+	if dc := req.GetDc(); dc > 0 && dc != rpc.Dc {
+		// Cross-domain lookup ; NOT Found
+		return &impb.ApplicationList{Page: max(1, req.GetPage())}, nil
+	}
+
+	fields, err := model.ParseAppFields(req.GetFields())
+	if err != nil {
+		return nil, err
+	}
+
+	sort, err := model.ParseAppSort(req.GetSort())
+	if err != nil {
+		return nil, err
+	}
+
 	list, err := c.store.Search(store.SearchAppRequest{
 		Context: ctx,
-		Dc:      req.GetDc(),
+		Dc:      rpc.Dc,
 		Id:      req.GetId(),
+		Q:       req.GetQ(),
+		Fields:  fields,
+		Sort:    sort,
 		Page:    int(req.GetPage()),
 		Size:    int(req.GetSize()),
 	})
@@ -54,22 +95,121 @@ func (c *ApplicationService) SearchApps(ctx context.Context, req *impb.SearchApp
 	}
 
 	for _, row := range list.Data {
-		res.Data = append(res.Data, row.Proto())
+		res.Data = append(res.Data, model.SelectApp(
+			row.Masked(), fields,
+		))
 	}
 
 	return res, nil
 }
 
 func (c *ApplicationService) DeleteApps(ctx context.Context, req *impb.DeleteAppRequest) (*impb.ApplicationList, error) {
-	return c.UnimplementedApplicationsServer.DeleteApps(ctx, req)
+	// return c.UnimplementedApplicationsServer.DeleteApps(ctx, req)
+
+	rpc, err := c.authorize(ctx, handler.AccessDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.GetId()) == 0 {
+		return nil, errors.BadRequest(
+			errors.Message("app: delete( id: ? ); client_id required"),
+		)
+	}
+
+	list, err := c.store.Revoke(
+		store.RevokeAppRequest{
+			Context: ctx,
+			Dc:      rpc.Dc,
+			Id:      req.GetId(),
+			Delete:  true,
+			By:      handler.AdminMutation(rpc),
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &impb.ApplicationList{
+		Data: make([]*impb.Application, 0, len(list.Data)),
+		Page: 1,
+	}
+
+	for _, row := range list.Data {
+		res.Data = append(res.Data, row.Masked())
+	}
+
+	return res, nil
+}
+
+func (c *ApplicationService) RevokeApp(ctx context.Context, req *impb.RevokeAppRequest) (*impb.Application, error) {
+
+	mode := handler.AccessWrite
+	if req.GetDelete() {
+		mode = handler.AccessDelete
+	}
+
+	rpc, err := c.authorize(ctx, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetId() == "" {
+		return nil, errors.BadRequest(
+			errors.Message("app: revoke( id: ? ); client_id required"),
+		)
+	}
+
+	var reason error
+	if src := req.GetReason(); src != nil {
+		reason = status.ErrorProto(src)
+	}
+
+	app, err := model.Get(c.store.Revoke(
+		store.RevokeAppRequest{
+			Context: ctx,
+			Dc:      rpc.Dc,
+			Id:      []string{req.GetId()},
+			Reason:  reason,
+			Delete:  req.GetDelete(),
+			By:      handler.AdminMutation(rpc),
+		},
+	))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if app == nil {
+		return nil, errors.NotFound(
+			errors.Message("app: client_id( %s ); not found or already revoked", req.GetId()),
+		)
+	}
+
+	return app.Masked(), nil
 }
 
 func (c *ApplicationService) CreateApp(ctx context.Context, req *impb.CreateAppRequest) (*impb.Application, error) {
 	// return c.UnimplementedApplicationsServer.CreateApp(ctx, req)
 
-	input := req.GetApp()
+	rpc, err := c.authorize(ctx, handler.AccessCreate)
+	if err != nil {
+		return nil, err
+	}
+
+	input := proto.CloneOf(req.GetApp())
+	if input == nil {
+		input = &impb.InputApp{}
+	}
+	// Business [Domain] of the caller ONLY !
+	input.Dc = rpc.Dc
 	src := model.NewApplication(input)
 
+	if err = src.Validate(); err != nil {
+		return nil, err
+	}
+
 	// app := &impb.Application{
 	// 	Dc:       input.GetDc(),
 	// 	Id:       uuid.NewString(),
@@ -86,6 +226,7 @@ func (c *ApplicationService) CreateApp(ctx context.Context, req *impb.CreateAppR
 		store.CreateAppRequest{
 			Context: ctx,
 			App:     src,
+			By:      handler.AdminMutation(rpc),
 		},
 	)
 
@@ -93,9 +234,541 @@ func (c *ApplicationService) CreateApp(ctx context.Context, req *impb.CreateAppR
 		return nil, err
 	}
 
-	return app.Proto(), nil
+	return app.Masked(), nil
 }
 
 func (c *ApplicationService) UpdateApp(ctx context.Context, req *impb.UpdateAppRequest) (*impb.Application, error) {
-	return c.UnimplementedApplicationsServer.UpdateApp(ctx, req)
+	// return c.UnimplementedApplicationsServer.UpdateApp(ctx, req)
+
+	rpc, err := c.authorize(ctx, handler.AccessWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetId() == "" {
+		return nil, errors.BadRequest(
+			errors.Message("app: update( id: ? ); client_id required"),
+		)
+	}
+
+	if req.GetVer() < 1 {
+		return nil, errors.BadRequest(
+			errors.Message("app: update( ver: ? ); current version required"),
+		)
+	}
+
+	app, err := c.findApp(rpc, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	// Rejects update early, if already modified
+	if app.Version() != req.GetVer() {
+		return nil, model.ErrAppModified
+	}
+
+	src, err := app.Update(
+		req.GetApp(), req.GetUpdateMask(),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = src.Validate(); err != nil {
+		return nil, err
+	}
+
+	res, err := c.store.Update(
+		store.UpdateAppRequest{
+			Context: ctx,
+			App:     src,
+			By:      handler.AdminMutation(rpc),
+			Diff:    model.AppDiff(app, src),
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if res == nil {
+		// concurrent update ; or deleted
+		return nil, model.ErrAppModified
+	}
+
+	return res.Masked(), nil
+}
+
+func (c *ApplicationService) ListAppVersions(ctx context.Context, req *impb.ListAppVersionsRequest) (*impb.AppVersionList, error) {
+
+	rpc, err := c.authorize(ctx, handler.AccessRead)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetAppId() == "" {
+		return nil, errors.BadRequest(
+			errors.Message("app: versions( app_id: ? ); client_id required"),
+		)
+	}
+
+	list, err := c.store.History(
+		store.SearchAppHistoryRequest{
+			Context: ctx,
+			Dc:      rpc.Dc,
+			AppId:   req.GetAppId(),
+			Ver:     req.GetVer(),
+			Page:    int(req.GetPage()),
+			Size:    int(req.GetSize()),
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &impb.AppVersionList{
+		Data: make([]*impb.AppVersion, 0, len(list.Data)),
+		Page: max(1, req.GetPage()),
+		Next: (list.Next != nil),
+	}
+
+	for _, row := range list.Data {
+		res.Data = append(res.Data, row.Proto())
+	}
+
+	return res, nil
+}
+
+func (c *ApplicationService) RestoreApp(ctx context.Context, req *impb.RestoreAppRequest) (*impb.Application, error) {
+
+	rpc, err := c.authorize(ctx, handler.AccessWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetRestore() < 1 {
+		return nil, errors.BadRequest(
+			errors.Message("app: restore( restore: ? ); version required"),
+		)
+	}
+
+	if req.GetVer() < 1 {
+		return nil, errors.BadRequest(
+			errors.Message("app: restore( ver: ? ); current version required"),
+		)
+	}
+
+	app, err := c.findApp(rpc, req.GetAppId())
+	if err != nil {
+		return nil, err
+	}
+
+	// Rejects restore early, if already modified
+	if app.Version() != req.GetVer() {
+		return nil, model.ErrAppModified
+	}
+
+	from, err := model.Get(c.store.History(
+		store.SearchAppHistoryRequest{
+			Context: ctx,
+			Dc:      rpc.Dc,
+			AppId:   req.GetAppId(),
+			Ver:     req.GetRestore(),
+		},
+	))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if from == nil {
+		return nil, errors.NotFound(
+			errors.Message("app: client_id( %s ); version( %d ) not found", req.GetAppId(), req.GetRestore()),
+		)
+	}
+
+	src := app.Restore(from.App)
+	if err = src.Validate(); err != nil {
+		return nil, err
+	}
+
+	res, err := c.store.Update(
+		store.UpdateAppRequest{
+			Context:  ctx,
+			App:      src,
+			By:       handler.AdminMutation(rpc),
+			Diff:     model.AppDiff(app, src),
+			Restored: from.Ver,
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if res == nil {
+		// concurrent update ; or deleted
+		return nil, model.ErrAppModified
+	}
+
+	return res.Masked(), nil
+}
+
+// findApp of the [rpc.Dc] domain by [client_id] ; NotFound error.
+func (c *ApplicationService) findApp(rpc *handler.Context, id string) (*model.Application, error) {
+
+	if id == "" {
+		return nil, errors.BadRequest(
+			errors.Message("app: client_id required"),
+		)
+	}
+
+	app, err := model.Get(c.store.Search(
+		store.SearchAppRequest{
+			Context: rpc.Context,
+			Dc:      rpc.Dc,
+			Id:      id,
+			Page:    1,
+			Size:    1,
+		},
+	))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if app == nil || app.ClientId() != id {
+		return nil, errors.NotFound(
+			errors.Message("app: client_id( %s ); not found", id),
+		)
+	}
+
+	return app, nil
+}
+
+func (c *ApplicationService) IssueSecret(ctx context.Context, req *impb.IssueSecretRequest) (*impb.ClientSecret, error) {
+
+	rpc, err := c.authorize(ctx, handler.AccessWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := c.findApp(rpc, req.GetAppId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = app.Authorize(); err != nil {
+		// Revoked !
+		return nil, err
+	}
+
+	opts := c.srv.Options()
+	overlap, err := opts.SecretPolicy.OverlapOf(
+		req.GetOverlap().AsDuration(),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	date := model.LocalTime.Now()
+	secret, err := model.NewClientSecret(app, date)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := opts.Secrets.Issue(
+		store.IssueAppSecretRequest{
+			Context: ctx,
+			Secret:  secret,
+			Expires: date.Add(overlap),
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if res == nil {
+		// concurrently revoked ; or deleted
+		return nil, errors.NotFound(
+			errors.Message("app: client_id( %s ); not found or revoked", req.GetAppId()),
+		)
+	}
+
+	return res.Proto(), nil
+}
+
+func (c *ApplicationService) ListSecrets(ctx context.Context, req *impb.ListSecretsRequest) (*impb.ClientSecretList, error) {
+
+	rpc, err := c.authorize(ctx, handler.AccessRead)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetAppId() == "" {
+		return nil, errors.BadRequest(
+			errors.Message("app: secrets( app_id: ? ); client_id required"),
+		)
+	}
+
+	list, err := c.srv.Options().Secrets.Search(
+		store.SearchAppSecretRequest{
+			Context: ctx,
+			Dc:      rpc.Dc,
+			AppId:   req.GetAppId(),
+			All:     req.GetAll(),
+			Date:    model.LocalTime.Now(),
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &impb.ClientSecretList{
+		Data: make([]*impb.ClientSecret, 0, len(list)),
+	}
+
+	for _, row := range list {
+		res.Data = append(res.Data, row.Proto())
+	}
+
+	return res, nil
+}
+
+func (c *ApplicationService) RevokeSecret(ctx context.Context, req *impb.RevokeSecretRequest) (*impb.ClientSecret, error) {
+
+	rpc, err := c.authorize(ctx, handler.AccessWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetAppId() == "" || req.GetId() == "" {
+		return nil, errors.BadRequest(
+			errors.Message("app: secret( app_id: ?, id: ? ); required"),
+		)
+	}
+
+	res, err := c.srv.Options().Secrets.Revoke(
+		store.RevokeAppSecretRequest{
+			Context: ctx,
+			Dc:      rpc.Dc,
+			AppId:   req.GetAppId(),
+			Id:      req.GetId(),
+			Date:    model.LocalTime.Now(),
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if res == nil {
+		return nil, errors.NotFound(
+			errors.Message("app: secret( %s ); not found or already revoked", req.GetId()),
+		)
+	}
+
+	return res.Proto(), nil
+}
+
+// pushDeliveryStates of the (admin) filter.
+var pushDeliveryStates = map[impb.PushDelivery_State]string{
+	impb.PushDelivery_PENDING: model.PushPending,
+	impb.PushDelivery_FAILED:  model.PushFailed,
+}
+
+func (c *ApplicationService) ListPushDeliveries(ctx context.Context, req *impb.ListPushDeliveriesRequest) (*impb.PushDeliveryList, error) {
+
+	rpc, err := c.authorize(ctx, handler.AccessRead)
+	if err != nil {
+		return nil, err
+	}
+
+	var states []string
+	for _, state := range req.GetState() {
+		name, ok := pushDeliveryStates[state]
+		if !ok {
+			return nil, errors.BadRequest(
+				errors.Message("push: deliveries( state: %s ); invalid", state),
+			)
+		}
+		states = append(states, name)
+	}
+
+	list, err := c.srv.Options().Outbox.Search(
+		store.SearchPushRequest{
+			Context:   ctx,
+			Dc:        rpc.Dc,
+			AppId:     req.GetAppId(),
+			SessionId: req.GetSessionId(),
+			State:     states,
+			Page:      int(req.GetPage()),
+			Size:      int(req.GetSize()),
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &impb.PushDeliveryList{
+		Data: make([]*impb.PushDelivery, 0, len(list.Data)),
+		Page: max(1, req.GetPage()),
+		Next: (list.Next != nil),
+	}
+
+	for _, row := range list.Data {
+		res.Data = append(res.Data, row.Proto())
+	}
+
+	return res, nil
+}
+
+// Default test PUSH notification text
+const (
+	testPushTitle = "Test notification"
+	testPushBody  = "PUSH service configuration test"
+)
+
+// testPushOutcomes of the (admin) diagnostics.
+var testPushOutcomes = map[push.Outcome]impb.TestPushResponse_Outcome{
+	push.Delivered:    impb.TestPushResponse_DELIVERED,
+	push.Retry:        impb.TestPushResponse_RETRY,
+	push.Failed:       impb.TestPushResponse_FAILED,
+	push.Unregistered: impb.TestPushResponse_UNREGISTERED,
+}
+
+// testPushCauses of the (admin) diagnostics.
+var testPushCauses = map[push.Cause]impb.TestPushResponse_Cause{
+	push.CauseNone:        impb.TestPushResponse_NONE,
+	push.CauseConfig:      impb.TestPushResponse_CONFIG,
+	push.CauseCredentials: impb.TestPushResponse_CREDENTIALS,
+	push.CauseToken:       impb.TestPushResponse_TOKEN,
+	push.CauseProvider:    impb.TestPushResponse_PROVIDER,
+	push.CauseNetwork:     impb.TestPushResponse_NETWORK,
+	push.CauseRequest:     impb.TestPushResponse_REQUEST,
+}
+
+// TestPush sends the test notification synchronously to the session device
+// registration or the raw device token, via the App PUSH service configured.
+// Bypasses the outbox ; device token(s) never expired.
+func (c *ApplicationService) TestPush(ctx context.Context, req *impb.TestPushRequest) (*impb.TestPushResponse, error) {
+
+	rpc, err := c.authorize(ctx, handler.AccessWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := c.srv.Options()
+	target := dispatch.Target{
+		Session: &model.Authorization{Dc: rpc.Dc},
+	}
+
+	appId := req.GetAppId()
+	if id := req.GetSessionId(); id != "" {
+		session, err := model.Get(opts.Sessions.Search(
+			store.ListSessionRequest{
+				Context: rpc.Context,
+				Dc:      rpc.Dc,
+				Id:      id,
+				Page:    1,
+				Size:    1,
+			},
+		))
+		if err != nil {
+			return nil, err
+		}
+		if session == nil || session.Id != id {
+			return nil, errors.NotFound(
+				errors.Message("push: session( %s ); not found", id),
+			)
+		}
+		if appId == "" {
+			appId = session.AppId
+		} else if appId != session.AppId {
+			return nil, errors.BadRequest(
+				errors.Message("push: session( %s ); app_id mismatch", id),
+			)
+		}
+		target.Session = session
+		switch typeOf := req.GetType(); typeOf {
+		case "":
+			target.Token = session.Device.Push.Primary()
+		case model.PushAlert, model.PushVoIP, model.PushWeb:
+			target.Token = session.Device.Push[typeOf]
+		default:
+			return nil, errors.BadRequest(
+				errors.Message("push: type( %s ); invalid", typeOf),
+			)
+		}
+	} else {
+		target.Token, err = dispatch.ParseToken(
+			req.GetProvider(), req.GetToken(), req.GetVoip(),
+		)
+		if err != nil {
+			return nil, errors.BadRequest(
+				errors.Message("%v", err),
+			)
+		}
+		target.Session.AppId = appId
+	}
+
+	app, err := c.findApp(rpc, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = app.Authorize(); err != nil {
+		// Revoked !
+		return nil, err
+	}
+	target.App = app
+
+	msg := &push.Message{
+		Title:    req.GetTitle(),
+		Body:     req.GetBody(),
+		Priority: push.PriorityHigh,
+	}
+	if msg.Silent() {
+		msg.Title = testPushTitle
+		msg.Body = testPushBody
+	}
+
+	start := time.Now()
+	re := opts.Push.Send(rpc.Context, []dispatch.Target{target}, msg)[0]
+	latency := time.Since(start)
+
+	res := &impb.TestPushResponse{
+		AppId:     appId,
+		SessionId: req.GetSessionId(),
+		Provider:  re.Provider,
+		Outcome:   testPushOutcomes[re.Outcome],
+		Cause:     testPushCauses[re.Cause()],
+		Status:    int32(re.Status),
+		Reason:    re.Reason,
+		MessageId: re.Id,
+		Response:  string(re.Response),
+		Latency:   latency.Milliseconds(),
+	}
+	if re.Err != nil {
+		res.Error = re.Err.Error()
+	}
+
+	c.logger.Info(
+		"[ PUSH ] test",
+		"app", appId,
+		"session", res.SessionId,
+		"provider", res.Provider,
+		"outcome", re.Outcome.String(),
+		"cause", re.Cause().String(),
+		"status", re.Status,
+		"latency", latency,
+	)
+
+	return res, nil
 }
@@ -0,0 +1,189 @@
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/prototext"
+
+	adpb "github.com/webitel/im-account-service/internal/client/webitel/proto/gen/auth"
+	"github.com/webitel/im-account-service/internal/handler"
+	v1 "github.com/webitel/im-account-service/internal/handler/grpc/v1"
+	"github.com/webitel/im-account-service/internal/model"
+	"github.com/webitel/im-account-service/internal/push"
+	"github.com/webitel/im-account-service/internal/push/dispatch"
+	"github.com/webitel/im-account-service/internal/store"
+	impb "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+	authpb "github.com/webitel/im-account-service/proto/gen/im/service/auth/v1"
+)
+
+// appStore stub of the single [app].
+type appStore struct {
+	store.AppStore // NOT implemented
+	app            *model.Application
+}
+
+func (c *appStore) Search(req store.SearchAppRequest) (*model.ApplicationList, error) {
+	if req.Id != c.app.ClientId() || req.Dc != c.app.GetDc() {
+		return nil, nil
+	}
+	return &model.ApplicationList{Data: []*model.Application{c.app}}, nil
+}
+
+// sessionStore stub of the single [session].
+type sessionStore struct {
+	store.SessionStore // NOT implemented
+	session            *model.Authorization
+}
+
+func (c *sessionStore) Search(req store.ListSessionRequest) (*model.SessionList, error) {
+	if req.Id != c.session.Id || req.Dc != c.session.Dc {
+		return nil, nil
+	}
+	return &model.SessionList{Data: []*model.Authorization{c.session}}, nil
+}
+
+// pushSender stub of the PUSH service [result].
+type pushSender struct {
+	result *push.Result
+	token  string
+	msg    *push.Message
+}
+
+func (c *pushSender) Send(_ context.Context, token string, msg *push.Message) *push.Result {
+	c.token, c.msg = token, msg
+	return c.result
+}
+
+func TestApplicationServiceTestPush(t *testing.T) {
+
+	app := model.ProtoApplication(&impb.Application{
+		Dc: 1, Id: "9a5b6e31-3f0e-4c2b-8f27-6c1f1d0b8e01", Ver: 1, Name: "app",
+		Service: &impb.ServiceApp{
+			Secret: "client-secret-0123456789",
+			PushService: &impb.PUSHServiceClient{
+				Fcm: &impb.PushFCMServiceClient{
+					Account: []byte(`{"private_key": "fcm-private-key-0123456789"}`),
+				},
+				Apn: &impb.PushAPNServiceClient{
+					Topic: "com.example.app",
+					Token: &impb.PushAPNServiceClient_Token{
+						KeyId: "KEY", TeamId: "TEAM",
+						AuthKey: []byte("apns-auth-key-0123456789"),
+					},
+				},
+			},
+		},
+	})
+
+	session := &model.Authorization{
+		Dc: 1, Id: "session", AppId: app.ClientId(),
+		Device: model.Device{Push: model.PushTokens{
+			model.PushAlert: {Token: &authpb.PUSHSubscription_Fcm{Fcm: "fcm-alert"}},
+			model.PushVoIP: {Type: authpb.PUSHSubscription_VOIP,
+				Token: &authpb.PUSHSubscription_Apn{Apn: "apn-voip"},
+			},
+		}},
+	}
+
+	for _, test := range []struct {
+		name   string
+		req    *impb.TestPushRequest
+		result *push.Result
+
+		provider string
+		token    string // sent to
+		outcome  impb.TestPushResponse_Outcome
+		cause    impb.TestPushResponse_Cause
+		failed   bool // [error] text
+	}{
+		{
+			name: "delivered",
+			req:  &impb.TestPushRequest{AppId: app.ClientId(), Provider: dispatch.FCM, Token: "fcm-device"},
+			result: &push.Result{
+				Outcome: push.Delivered, Id: "projects/app/messages/1", Status: 200,
+				Response: []byte(`{"name": "projects/app/messages/1"}`),
+			},
+			provider: dispatch.FCM, token: "fcm-device",
+			outcome: impb.TestPushResponse_DELIVERED, cause: impb.TestPushResponse_NONE,
+		},
+		{
+			name: "unregistered",
+			req:  &impb.TestPushRequest{AppId: app.ClientId(), Provider: dispatch.APN, Token: "apn-device"},
+			result: &push.Result{
+				Outcome: push.Unregistered, Status: 410, Reason: "Unregistered",
+				Response: []byte(`{"reason": "Unregistered"}`),
+				Err:      fmt.Errorf("apns: (#410) Unregistered"),
+			},
+			provider: dispatch.APN, token: "apn-device",
+			outcome: impb.TestPushResponse_UNREGISTERED, cause: impb.TestPushResponse_TOKEN,
+			failed: true,
+		},
+		{
+			name: "retry",
+			req:  &impb.TestPushRequest{SessionId: session.Id, Type: model.PushVoIP},
+			result: &push.Result{
+				Outcome: push.Retry, Status: 503, Reason: "ServiceUnavailable",
+				Err: fmt.Errorf("apns: (#503) ServiceUnavailable"),
+			},
+			provider: dispatch.APN, token: "apn-voip",
+			outcome: impb.TestPushResponse_RETRY, cause: impb.TestPushResponse_PROVIDER,
+			failed: true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+
+			sender := &pushSender{result: test.result}
+			srv, _ := handler.NewService(handler.ServiceOptions{
+				Logger:   slog.New(slog.DiscardHandler),
+				Sessions: &sessionStore{session: session},
+				Push: dispatch.New(dispatch.Options{
+					Logger: slog.New(slog.DiscardHandler),
+					NewSender: func(*model.Application, string) (push.Sender, error) {
+						return sender, nil
+					},
+				}),
+			})
+			api := v1.NewApplicationService(srv, &appStore{app: app}, slog.New(slog.DiscardHandler))
+
+			// Authorized admin
+			ctx := handler.WithContext(context.Background(), &handler.Context{
+				Dc: 1, Auth: &adpb.Userinfo{Dc: 1, Permissions: []*adpb.Permission{{Id: "write"}}},
+			})
+
+			res, err := api.TestPush(ctx, test.req)
+			if err != nil {
+				t.Fatalf("TestPush() error = %v", err)
+			}
+
+			if sender.token != test.token || sender.msg.Silent() {
+				t.Errorf("TestPush() sent to %q ; silent: %t, want %q", sender.token, sender.msg.Silent(), test.token)
+			}
+			if res.GetAppId() != app.ClientId() || res.GetSessionId() != test.req.GetSessionId() ||
+				res.GetProvider() != test.provider || res.GetOutcome() != test.outcome || res.GetCause() != test.cause ||
+				res.GetStatus() != int32(test.result.Status) || res.GetReason() != test.result.Reason ||
+				res.GetMessageId() != test.result.Id || res.GetResponse() != string(test.result.Response) {
+				t.Errorf("TestPush() = %v", res)
+			}
+			if (res.GetError() != "") != test.failed {
+				t.Errorf("TestPush() error = %q, want failed: %t", res.GetError(), test.failed)
+			}
+
+			// No credential(s) echoed
+			text := prototext.Format(res)
+			for path, secret := range model.AppSecrets(app.Proto(), false) {
+				if strings.Contains(text, string(secret)) {
+					t.Errorf("TestPush() = %v ; echoed [%s] secret", res, path)
+				}
+			}
+			for _, secret := range []string{"fcm-private-key", "apns-auth-key", "client-secret"} {
+				if strings.Contains(text, secret) {
+					t.Errorf("TestPush() = %v ; echoed %q", res, secret)
+				}
+			}
+		})
+	}
+}
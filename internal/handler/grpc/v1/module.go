@@ -13,9 +13,11 @@ var Module = fx.Module(
 	fx.Provide(
 		NewAccountService,
 		NewApplicationService,
+		NewNotificationService,
 	),
 	fx.Invoke(
 		RegisterAccountService,
 		RegisterApplicationService,
+		RegisterNotificationService,
 	),
 )
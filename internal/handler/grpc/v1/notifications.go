@@ -0,0 +1,60 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/protobuf/proto"
+
+	grpcsrv "github.com/webitel/im-account-service/infra/server/grpc"
+	"github.com/webitel/im-account-service/internal/errors"
+	"github.com/webitel/im-account-service/internal/handler"
+	v1 "github.com/webitel/im-account-service/proto/gen/im/service/auth/v1"
+)
+
+// Internal Notification Service
+type NotificationService struct {
+	v1.UnimplementedNotificationsServer
+
+	srv *handler.Service
+}
+
+var _ v1.NotificationsServer = (*NotificationService)(nil)
+
+func NewNotificationService(handler *handler.Service) *NotificationService {
+	return &NotificationService{srv: handler}
+}
+
+func RegisterNotificationService(server *grpcsrv.Server, handler *NotificationService) {
+	v1.RegisterNotificationsServer(server.Server, handler)
+}
+
+// ------------------------------- [API] v1 ---------------------------------------- //
+
+// Send notification to the contact's (or specific session(s)) device(s).
+// Requires the Webitel admin granted with the Applications [write] access ;
+// recipient(s) are bound to the admin's own domain.
+func (api *NotificationService) SendNotification(ctx context.Context, req *v1.SendNotificationRequest) (*v1.SendNotificationResponse, error) {
+
+	rpc, err := api.srv.GetContext(
+		ctx, handler.AdminAuthorization(
+			handler.ObjclassApps, handler.AccessWrite,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if dc := req.GetDc(); dc > 0 && dc != rpc.Dc {
+		return nil, errors.New(
+			errors.Code(http.StatusForbidden),
+			errors.Status("FORBIDDEN"),
+			errors.Message("notify: business account ( dc ) access denied"),
+		)
+	}
+
+	req = proto.CloneOf(req)
+	req.Dc = rpc.Dc
+
+	return api.srv.SendNotification(ctx, req)
+}
@@ -27,4 +27,12 @@ var Module = fx.Module(
 		},
 		NewService,
 	),
+	fx.Invoke(
+		SubscribeNotifications,
+		StartPushTokenSweep,
+		StartPushOutbox,
+		StartUpdateOutbox,
+		StartEventRelay,
+		StartSessionTouch,
+	),
 )
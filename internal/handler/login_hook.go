@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/webitel/im-account-service/internal/errors"
+	"github.com/webitel/im-account-service/internal/model"
+	clientpb "github.com/webitel/im-account-service/proto/gen/im/client/v1"
+	adminpb "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+)
+
+// LoginHook asks the [app] backend to approve the [contact] sign-in
+// from the [device], if the [service.login_hook] is configured.
+// The [contact] profile is patched with the response details, if any.
+//
+// Returns 403 LOGIN_DENIED error if denied ; 503 LOGIN_HOOK_UNAVAILABLE
+// if the endpoint fails unless the hook [fail_open].
+func (srv *Service) LoginHook(ctx context.Context, app *model.Application, device *model.Device, contact *model.Contact) (patched bool, err error) {
+
+	hook := model.LoginHook(app)
+	if hook == nil {
+		return false, nil // not configured
+	}
+
+	req := &clientpb.LoginRequest{
+		Dc:      app.GetDc(),
+		AppId:   app.ClientId(),
+		Date:    model.LocalTime.Now().UnixMilli(),
+		Contact: UpdateContactProfile(contact),
+	}
+	if device != nil {
+		req.Device = &clientpb.LoginDevice{
+			Id:        device.Id,
+			UserAgent: device.App.String,
+		}
+		if ip := device.IP(); ip != nil {
+			req.Device.Ip = ip.String()
+		}
+	}
+
+	start := time.Now()
+	res, err := srv.checkLogin(ctx, hook, req)
+	if err != nil {
+		srv.opts.Logger.Warn(
+			"[ LOGIN ] hook; "+err.Error(),
+			"app.id", req.AppId,
+			"contact.iss", contact.Iss,
+			"contact.sub", contact.Sub,
+			"fail_open", hook.GetFailOpen(),
+			"spent", time.Since(start),
+		)
+		if hook.GetFailOpen() {
+			return false, nil // allow as is
+		}
+		return false, errors.New(
+			errors.Code(503),
+			errors.Status("LOGIN_HOOK_UNAVAILABLE"),
+			errors.Message("login: app backend unavailable"),
+		)
+	}
+
+	srv.opts.Logger.Debug(
+		"[ LOGIN ] hook",
+		"app.id", req.AppId,
+		"contact.iss", contact.Iss,
+		"contact.sub", contact.Sub,
+		"action", res.GetAction().String(),
+		"spent", time.Since(start),
+	)
+
+	if res.GetAction() == clientpb.LoginResponse_DENY {
+		message := res.GetMessage()
+		if message == "" {
+			message = "login: denied by the app backend"
+		}
+		return false, errors.New(
+			errors.Code(403),
+			errors.Status("LOGIN_DENIED"),
+			errors.Message("%s", message),
+		)
+	}
+
+	return LoginContactPatch(contact, res.GetContact()), nil
+}
+
+// checkLogin calls the [hook] endpoint within the hook timeout.
+func (srv *Service) checkLogin(ctx context.Context, hook *adminpb.LoginHook, req *clientpb.LoginRequest) (*clientpb.LoginResponse, error) {
+
+	ctx, cancel := context.WithTimeout(ctx, model.LoginHookDeadline(hook))
+	defer cancel()
+
+	switch hook.GetEndpoint().(type) {
+	case *adminpb.LoginHook_Grpc:
+		if client := srv.opts.Partners; client != nil {
+			return client.CheckLogin(ctx, hook, req)
+		}
+	case *adminpb.LoginHook_Web:
+		if client := srv.opts.Webhooks; client != nil {
+			return client.CheckLogin(ctx, hook, req)
+		}
+	}
+
+	return nil, fmt.Errorf("%T endpoint not supported", hook.GetEndpoint())
+}
+
+// LoginContactPatch applies the [patch] profile field(s) given to the [dst] contact.
+// Metadata key(s) of the empty value are removed. Reports whether [dst] is changed.
+func LoginContactPatch(dst *model.Contact, patch *clientpb.ContactPatch) (changed bool) {
+
+	if patch == nil {
+		return false
+	}
+
+	set := func(dst *string, src *string) {
+		if src != nil && *dst != *src {
+			*dst, changed = *src, true
+		}
+	}
+
+	set(&dst.Sub, patch.Sub)
+	set(&dst.Name, patch.Name)
+	set(&dst.Username, patch.Username)
+	set(&dst.Email, patch.Email)
+	set(&dst.PhoneNumber, patch.PhoneNumber)
+	set(&dst.Locale, patch.Locale)
+
+	for key, value := range patch.GetMetadata() {
+		if value == "" {
+			if _, ok := dst.Metadata[key]; ok {
+				delete(dst.Metadata, key)
+				changed = true
+			}
+			continue
+		}
+		if dst.Metadata == nil {
+			dst.Metadata = make(map[string]any)
+		}
+		if dst.Metadata[key] != value {
+			dst.Metadata[key] = value
+			changed = true
+		}
+	}
+
+	return changed
+}
@@ -0,0 +1,229 @@
+package handler_test
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/webitel/im-account-service/internal/errors"
+	"github.com/webitel/im-account-service/internal/handler"
+	"github.com/webitel/im-account-service/internal/model"
+	"github.com/webitel/im-account-service/internal/updates"
+	clientpb "github.com/webitel/im-account-service/proto/gen/im/client/v1"
+	adminpb "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+)
+
+// loginChecker stub of the [service.login_hook] endpoint client.
+type loginChecker struct {
+	updates.BatchClient // NOT implemented
+	check               func(ctx context.Context) (*clientpb.LoginResponse, error)
+	req                 *clientpb.LoginRequest
+}
+
+func (c *loginChecker) CheckLogin(ctx context.Context, _ *adminpb.LoginHook, req *clientpb.LoginRequest) (*clientpb.LoginResponse, error) {
+	c.req = req
+	return c.check(ctx)
+}
+
+func TestServiceLoginHook(t *testing.T) {
+
+	var (
+		allow = func(context.Context) (*clientpb.LoginResponse, error) {
+			return &clientpb.LoginResponse{}, nil
+		}
+		deny = func(context.Context) (*clientpb.LoginResponse, error) {
+			return &clientpb.LoginResponse{Action: clientpb.LoginResponse_DENY, Message: "blocked"}, nil
+		}
+		failure = func(context.Context) (*clientpb.LoginResponse, error) {
+			return nil, fmt.Errorf("webhook: (#500) boom")
+		}
+		timeout = func(ctx context.Context) (*clientpb.LoginResponse, error) {
+			<-ctx.Done() // hook [timeout]
+			return nil, ctx.Err()
+		}
+		patch = func(context.Context) (*clientpb.LoginResponse, error) {
+			return &clientpb.LoginResponse{
+				Contact: &clientpb.ContactPatch{
+					Name:     proto.String("John Doe"),
+					Metadata: map[string]string{"tier": "gold", "trial": ""},
+				},
+			}, nil
+		}
+	)
+
+	for _, test := range []struct {
+		name     string
+		grpc     bool // endpoint ; otherwise: web
+		failOpen bool
+		check    func(ctx context.Context) (*clientpb.LoginResponse, error)
+
+		code    int32  // error ; zero: none
+		status  string // error
+		patched bool
+		called  string // endpoint ; "": none
+	}{
+		{name: "allow", check: allow, called: "web"},
+		{name: "deny", grpc: true, check: deny, code: 403, status: "LOGIN_DENIED", called: "grpc"},
+		{name: "deny/fail_open", failOpen: true, check: deny, code: 403, status: "LOGIN_DENIED", called: "web"},
+		{name: "failure/fail_closed", check: failure, code: 503, status: "LOGIN_HOOK_UNAVAILABLE", called: "web"},
+		{name: "failure/fail_open", grpc: true, failOpen: true, check: failure, called: "grpc"},
+		{name: "timeout/fail_closed", grpc: true, check: timeout, code: 503, status: "LOGIN_HOOK_UNAVAILABLE", called: "grpc"},
+		{name: "timeout/fail_open", failOpen: true, check: timeout, called: "web"},
+		{name: "patch", check: patch, patched: true, called: "web"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+
+			var (
+				web  = &loginChecker{check: test.check}
+				grpc = &loginChecker{check: test.check}
+				hook = &adminpb.LoginHook{
+					Timeout:  100, // ms
+					FailOpen: test.failOpen,
+				}
+			)
+			if test.grpc {
+				hook.Endpoint = &adminpb.LoginHook_Grpc{
+					Grpc: &adminpb.GrpcServiceSubscription{Host: "grpc://crm.example.com"},
+				}
+			} else {
+				hook.Endpoint = &adminpb.LoginHook_Web{
+					Web: &adminpb.WebhookSubscription{Url: "https://crm.example.com/login"},
+				}
+			}
+
+			srv, _ := handler.NewService(handler.ServiceOptions{
+				Logger:   slog.New(slog.DiscardHandler),
+				Webhooks: web,
+				Partners: grpc,
+			})
+			app := model.ProtoApplication(&adminpb.Application{
+				Dc: 1, Id: "app",
+				Service: &adminpb.ServiceApp{LoginHook: hook},
+			})
+			contact := &model.Contact{
+				Iss: "https://idp.example.com", Sub: "user",
+				Metadata: map[string]any{"trial": "true"},
+			}
+
+			patched, err := srv.LoginHook(context.Background(), app, &model.Device{Id: "device"}, contact)
+
+			if test.code == 0 && err != nil {
+				t.Fatalf("LoginHook() error = %v, want none", err)
+			}
+			if test.code != 0 {
+				re, _ := errors.FromError(err)
+				if re.Proto().GetCode() != test.code || re.Proto().GetStatus() != test.status {
+					t.Fatalf("LoginHook() error = %v, want (#%d) %s", err, test.code, test.status)
+				}
+			}
+			if patched != test.patched {
+				t.Errorf("LoginHook() patched = %t, want %t", patched, test.patched)
+			}
+
+			called := map[string]*loginChecker{"web": web, "grpc": grpc}
+			for name, client := range called {
+				if (client.req != nil) != (name == test.called) {
+					t.Errorf("LoginHook() %s endpoint called: %t, want %s", name, client.req != nil, test.called)
+				}
+			}
+			if req := called[test.called].req; req.GetAppId() != "app" || req.GetDc() != 1 ||
+				req.GetContact().GetSub() != "user" || req.GetDevice().GetId() != "device" {
+				t.Errorf("LoginHook() request = %v", req)
+			}
+
+			if test.patched {
+				want := map[string]any{"tier": "gold"}
+				if contact.Name != "John Doe" || !maps.Equal(contact.Metadata, want) {
+					t.Errorf("LoginHook() contact = %q %v, want %q %v", contact.Name, contact.Metadata, "John Doe", want)
+				}
+			}
+		})
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		srv, _ := handler.NewService(handler.ServiceOptions{
+			Logger: slog.New(slog.DiscardHandler),
+		})
+		app := model.ProtoApplication(&adminpb.Application{Dc: 1, Id: "app"})
+		patched, err := srv.LoginHook(context.Background(), app, nil, &model.Contact{Sub: "user"})
+		if patched || err != nil {
+			t.Errorf("LoginHook() = %t, %v; want false, nil", patched, err)
+		}
+	})
+}
+
+func TestLoginContactPatch(t *testing.T) {
+
+	for _, test := range []struct {
+		name     string
+		metadata map[string]any
+		patch    *clientpb.ContactPatch
+		changed  bool
+		want     model.Contact
+	}{
+		{
+			name:  "none",
+			patch: nil,
+			want:  model.Contact{Sub: "user", Name: "John"},
+		},
+		{
+			name:  "same",
+			patch: &clientpb.ContactPatch{Sub: proto.String("user"), Name: proto.String("John")},
+			want:  model.Contact{Sub: "user", Name: "John"},
+		},
+		{
+			name:    "fields",
+			patch:   &clientpb.ContactPatch{Sub: proto.String("crm-1"), Email: proto.String("john@example.com"), Locale: proto.String("uk")},
+			changed: true,
+			want:    model.Contact{Sub: "crm-1", Name: "John", Email: "john@example.com", Locale: "uk"},
+		},
+		{
+			name:    "clear",
+			patch:   &clientpb.ContactPatch{Name: proto.String("")},
+			changed: true,
+			want:    model.Contact{Sub: "user"},
+		},
+		{
+			name:     "metadata/set",
+			metadata: map[string]any{"tier": "silver"},
+			patch:    &clientpb.ContactPatch{Metadata: map[string]string{"tier": "gold", "crm": "1"}},
+			changed:  true,
+			want:     model.Contact{Sub: "user", Name: "John", Metadata: map[string]any{"tier": "gold", "crm": "1"}},
+		},
+		{
+			name:     "metadata/same",
+			metadata: map[string]any{"tier": "gold"},
+			patch:    &clientpb.ContactPatch{Metadata: map[string]string{"tier": "gold"}},
+			want:     model.Contact{Sub: "user", Name: "John", Metadata: map[string]any{"tier": "gold"}},
+		},
+		{
+			name:     "metadata/delete",
+			metadata: map[string]any{"tier": "gold", "trial": "true"},
+			patch:    &clientpb.ContactPatch{Metadata: map[string]string{"trial": ""}},
+			changed:  true,
+			want:     model.Contact{Sub: "user", Name: "John", Metadata: map[string]any{"tier": "gold"}},
+		},
+		{
+			name:    "metadata/delete/missing",
+			patch:   &clientpb.ContactPatch{Metadata: map[string]string{"trial": ""}},
+			changed: false,
+			want:    model.Contact{Sub: "user", Name: "John"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			dst := model.Contact{Sub: "user", Name: "John", Metadata: test.metadata}
+			changed := handler.LoginContactPatch(&dst, test.patch)
+			if changed != test.changed {
+				t.Errorf("LoginContactPatch() = %t, want %t", changed, test.changed)
+			}
+			if dst.Sub != test.want.Sub || dst.Name != test.want.Name || dst.Email != test.want.Email ||
+				dst.Locale != test.want.Locale || !maps.Equal(dst.Metadata, test.want.Metadata) {
+				t.Errorf("LoginContactPatch() contact = %+v, want %+v", dst, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,264 @@
+package handler
+
+import (
+	"context"
+	"slices"
+
+	"github.com/webitel/im-account-service/internal/errors"
+	"github.com/webitel/im-account-service/internal/model"
+	"github.com/webitel/im-account-service/internal/push"
+	"github.com/webitel/im-account-service/internal/push/dispatch"
+	"github.com/webitel/im-account-service/internal/store"
+	authpb "github.com/webitel/im-account-service/proto/gen/im/service/auth/v1"
+)
+
+// Maximum number of session(s) to notify per request
+const maxNotifySessions = 1000
+
+// SendNotification queues the [req] notification delivery
+// to all the recipient session(s) device(s) with the PUSH token registered.
+// Delivery is sent by the outbox worker(s) ; See [StartPushOutbox].
+// Result reports the outcome of each device ; QUEUED, unless rejected early.
+func (srv *Service) SendNotification(ctx context.Context, req *authpb.SendNotificationRequest) (*authpb.SendNotificationResponse, error) {
+
+	lookup, err := notifySessionsRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if NotificationMessage(req.GetNotification()) == nil {
+		return nil, errors.BadRequest(
+			errors.Message("notify: notification required"),
+		)
+	}
+
+	list, err := srv.opts.Sessions.Search(lookup)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &authpb.SendNotificationResponse{
+		Results: make([]*authpb.NotificationResult, 0, len(list.Data)),
+	}
+
+	// Recipient contact(s) locale ; template variant
+	template := req.GetNotification().GetTemplate()
+	locales := srv.notifyLocales(ctx, req.GetDc(), template, list.Data)
+
+	// Session client App(s) ; PUSH service(s) configuration
+	apps := make(map[string]*model.Application)
+	queue := make([]*model.PushDelivery, 0, len(list.Data))
+	queued := make([]*authpb.NotificationResult, 0, len(list.Data))
+	for _, session := range list.Data {
+		app, ok := apps[session.AppId]
+		if !ok && session.AppId != "" {
+			app, err = srv.GetApplication(ctx, session.AppId)
+			if err != nil {
+				return nil, err
+			}
+			if app != nil && app.Authorize() != nil {
+				app = nil // revoked
+			}
+			apps[session.AppId] = app
+		}
+		result := &authpb.NotificationResult{
+			SessionId: session.Id,
+			AppId:     session.AppId,
+		}
+		res.Results = append(res.Results, result)
+		token := session.Device.Push.Route(req.GetNotification().GetCall())
+		provider, _ := dispatch.Token(token)
+		result.Provider = provider
+		switch {
+		case provider == "":
+			result.Outcome = authpb.NotificationResult_UNREGISTERED
+			result.Reason = "NO_PUSH_TOKEN"
+			continue
+		case app == nil:
+			result.Outcome = authpb.NotificationResult_FAILED
+			result.Reason = "NO_PUSH_SERVICE"
+			continue
+		}
+		message := req.GetNotification()
+		if template != "" {
+			src := model.PushTemplate(app, template)
+			if src == nil {
+				result.Outcome = authpb.NotificationResult_FAILED
+				result.Reason = "NO_TEMPLATE"
+				continue
+			}
+			var locale string
+			if session.Contact != nil {
+				locale = locales[session.Contact.Id]
+			}
+			message = model.RenderPushTemplate(src, locale, message)
+		}
+		queue = append(queue, &model.PushDelivery{
+			Dc:        session.Dc,
+			SessionId: session.Id,
+			AppId:     session.AppId,
+			Provider:  provider,
+			Token:     token,
+			Message:   message,
+		})
+		queued = append(queued, result)
+	}
+
+	err = srv.opts.Outbox.Enqueue(ctx, queue)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, rec := range queue {
+		queued[i].Outcome = authpb.NotificationResult_QUEUED
+		queued[i].DeliveryId = rec.Id
+	}
+
+	if len(queue) > 0 {
+		srv.wakePushOutbox()
+	}
+
+	return res, nil
+}
+
+// notifyLocales returns the recipient session(s) contact(s) locale ; [contact.id]
+// Resolved for the [template] notification only.
+// Lookup failure falls back to the template default.
+func (srv *Service) notifyLocales(ctx context.Context, dc int64, template string, sessions []*model.Authorization) map[string]string {
+
+	if template == "" {
+		return nil
+	}
+
+	var ids []string
+	for _, session := range sessions {
+		if contact := session.Contact; contact != nil && contact.Id != "" && !slices.Contains(ids, contact.Id) {
+			ids = append(ids, contact.Id)
+		}
+	}
+
+	locales, err := srv.ContactLocales(ctx, dc, ids)
+	if err != nil {
+		srv.opts.Logger.Warn(
+			"[ PUSH ] template( "+template+" ) locale(s); "+err.Error(),
+			"contacts", len(ids),
+		)
+		return nil
+	}
+
+	return locales
+}
+
+// expirePushTokens clears the device token(s)
+// rejected by the PUSH service as no longer valid.
+func (srv *Service) expirePushTokens(ctx context.Context, results []dispatch.Result) {
+	var tokens []*model.PushToken
+	for _, re := range results {
+		if re.Outcome == push.Unregistered && re.Provider != "" {
+			tokens = append(tokens, re.Token)
+		}
+	}
+	if len(tokens) == 0 {
+		return
+	}
+	count, err := srv.opts.Sessions.ExpirePushTokens(
+		store.ExpirePushTokenRequest{
+			Context: ctx,
+			Tokens:  tokens,
+		},
+	)
+	if err != nil {
+		srv.opts.Logger.Error(
+			"[ PUSH ] expire invalid token(s); "+err.Error(),
+			"tokens", len(tokens),
+		)
+		return
+	}
+	srv.opts.Logger.Info(
+		"[ PUSH ] invalid token(s) expired",
+		"tokens", len(tokens),
+		"sessions", count,
+	)
+}
+
+// notifySessionsRequest returns the recipient session(s) lookup of the [req].
+func notifySessionsRequest(ctx context.Context, req *authpb.SendNotificationRequest) (store.ListSessionRequest, error) {
+
+	withPush := true
+	lookup := store.ListSessionRequest{
+		Context:   ctx,
+		Dc:        req.GetDc(),
+		AppId:     req.GetAppId(),
+		PushToken: &withPush,
+		Size:      maxNotifySessions,
+	}
+
+	if lookup.Dc <= 0 {
+		return lookup, errors.BadRequest(
+			errors.Message("notify: business account ( dc ) required"),
+		)
+	}
+
+	contact := req.GetContact()
+	sessions := req.GetSessionId()
+	if (contact.GetInput() == nil) == (len(sessions) == 0) {
+		return lookup, errors.BadRequest(
+			errors.Message("notify: recipient( contact | session_id ) required ; one of"),
+		)
+	}
+
+	if len(sessions) > maxNotifySessions {
+		return lookup, errors.BadRequest(
+			errors.Message("notify: session_id; too many ; max %d", maxNotifySessions),
+		)
+	}
+	lookup.Ids = sessions
+
+	switch input := contact.GetInput().(type) {
+	case nil:
+	case *authpb.InputContact_Id:
+		lookup.ContactId = &model.ContactId{
+			Dc: lookup.Dc, Id: input.Id,
+		}
+	case *authpb.InputContact_Source:
+		lookup.ContactId = &model.ContactId{
+			Dc:  lookup.Dc,
+			Iss: input.Source.GetIss(),
+			Sub: input.Source.GetSub(),
+		}
+	}
+
+	if lookup.ContactId != nil && !lookup.ContactId.IsValid() {
+		return lookup, errors.BadRequest(
+			errors.Message("notify: contact( id | source{iss,sub} ) required"),
+		)
+	}
+
+	return lookup, nil
+}
+
+// NotificationMessage returns the PUSH message of the [src] notification.
+// Nil if [src] is nil.
+func NotificationMessage(src *authpb.Notification) *push.Message {
+	if src == nil {
+		return nil
+	}
+	msg := &push.Message{
+		Title:    src.GetTitle(),
+		Body:     src.GetBody(),
+		Data:     src.GetData(),
+		TTL:      src.GetTtl().AsDuration(),
+		Collapse: src.GetCollapse(),
+		Sound:    src.GetSound(),
+		Category: src.GetCategory(),
+		Call:     src.GetCall(),
+	}
+	if src.GetPriority() == authpb.Notification_HIGH {
+		msg.Priority = push.PriorityHigh
+	}
+	if src.Badge != nil {
+		badge := int(src.GetBadge())
+		msg.Badge = &badge
+	}
+	return msg
+}
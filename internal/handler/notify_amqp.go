@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/webitel/im-account-service/infra/pubsub"
+	"github.com/webitel/im-account-service/infra/pubsub/factory"
+	"github.com/webitel/im-account-service/infra/x/logx"
+	authpb "github.com/webitel/im-account-service/proto/gen/im/service/auth/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Notification(s) broker topology.
+// Queue is shared by all the service node(s) ; competing consumers.
+const (
+	notifyExchange   = "im_account"
+	notifyQueue      = "im_account.notification"
+	notifyRoutingKey = "notification.send"
+)
+
+// SubscribeNotifications consumes the [SendNotificationRequest] message(s)
+// published to the [notifyExchange] with the [notifyRoutingKey].
+// Message body: application/json (default) or application/protobuf.
+func SubscribeNotifications(srv *Service, broker pubsub.Provider, logger *slog.Logger) error {
+
+	if broker == nil {
+		return nil
+	}
+
+	sub, err := broker.GetFactory().BuildSubscriber(
+		"", // name ; autogen
+		&factory.SubscriberConfig{
+			Exchange: factory.ExchangeConfig{
+				Name:    notifyExchange,
+				Type:    "topic",
+				Durable: true,
+			},
+			Queue:             notifyQueue,
+			RoutingKey:        notifyRoutingKey,
+			ExclusiveConsumer: false,
+		},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	logger = logx.ModuleLogger("notify", logger)
+	_ = broker.GetRouter().AddConsumerHandler(
+		"notifications",
+		// subscriber
+		notifyRoutingKey, sub,
+		// handler
+		func(recv *message.Message) error {
+			return srv.onNotification(recv, logger)
+		},
+	)
+
+	return nil
+}
+
+func (srv *Service) onNotification(recv *message.Message, logger *slog.Logger) error {
+
+	var (
+		req = &authpb.SendNotificationRequest{}
+		err error
+	)
+
+	contentType := recv.Metadata.Get(".content_type")
+	if strings.Contains(contentType, "proto") {
+		err = proto.Unmarshal(recv.Payload, req)
+	} else {
+		err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(recv.Payload, req)
+	}
+
+	if err != nil {
+		// Malformed ; ACK, do NOT redeliver !
+		logger.Error(
+			"[ RECV::MSG ] invalid notification; "+err.Error(),
+			"content_type", contentType,
+		)
+		return nil
+	}
+
+	ctx := recv.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	res, err := srv.SendNotification(ctx, req)
+	if err != nil {
+		// Bad request ; ACK, do NOT redeliver !
+		logger.Error(
+			"[ RECV::MSG ] notification rejected; "+err.Error(),
+			"dc", req.GetDc(),
+		)
+		return nil
+	}
+
+	var queued int
+	for _, re := range res.GetResults() {
+		if re.GetOutcome() == authpb.NotificationResult_QUEUED {
+			queued++
+		}
+	}
+	logger.Debug(
+		"[ RECV::MSG ] notification queued",
+		"dc", req.GetDc(),
+		"devices", len(res.GetResults()),
+		"queued", queued,
+	)
+
+	return nil
+}
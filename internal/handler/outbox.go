@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/webitel/im-account-service/internal/model"
+	"go.uber.org/fx"
+)
+
+// Interval to purge the outdated dead-letter record(s)
+const outboxPurgeInterval = time.Hour
+
+// outboxRunner runs the transactional outbox worker(s) of the [T] record(s).
+// Worker(s) of all the service node(s) compete for the pending record(s).
+type outboxRunner[T any] struct {
+	// Log tag, e.g.: PUSH
+	Name   string
+	Logger *slog.Logger
+	// Number of the concurrent worker(s) per node.
+	Workers int
+	// Maximum number of the record(s) claimed per worker at once.
+	Batch int
+	// Interval to poll for the pending record(s), if idle.
+	PollInterval time.Duration
+	// Wakes the (local) idle worker of the NEW record(s) enqueued ; OPTIONAL
+	Wake <-chan struct{}
+	// Claim the next batch of the pending record(s), up to [size].
+	Claim func(ctx context.Context, size int) ([]T, error)
+	// Deliver the claimed [list] record(s) and do the bookkeeping.
+	Deliver func(ctx context.Context, list []T)
+	// Purge the dead-letter record(s) failed before the given date ; OPTIONAL
+	Purge func(ctx context.Context, before time.Time) (int64, error)
+	// Keep dead-letter record(s) for this period ; zero: forever.
+	Retention time.Duration
+	// Extra lifecycle hook(s), e.g.: publisher ; OPTIONAL
+	OnStart func(context.Context) error
+	OnStop  func(context.Context) error
+}
+
+// wakeOutbox signals the (local) idle worker of the NEW record(s) enqueued.
+func wakeOutbox(wake chan<- struct{}) {
+	select {
+	case wake <- struct{}{}:
+	default: // already signaled
+	}
+}
+
+// Start runs the worker(s) within the [runtime] lifecycle.
+func (run *outboxRunner[T]) Start(runtime fx.Lifecycle) {
+
+	var (
+		ctx, stop = context.WithCancel(context.Background())
+		done      sync.WaitGroup
+	)
+
+	runtime.Append(fx.Hook{
+		OnStart: func(start context.Context) error {
+			if run.OnStart != nil {
+				if err := run.OnStart(start); err != nil {
+					return err
+				}
+			}
+			for range run.Workers {
+				done.Add(1)
+				go func() {
+					defer done.Done()
+					run.poll(ctx)
+				}()
+			}
+			if run.Purge != nil && run.Retention > 0 {
+				done.Add(1)
+				go func() {
+					defer done.Done()
+					run.purge(ctx)
+				}()
+			}
+			return nil
+		},
+		OnStop: func(wait context.Context) error {
+			stop()
+			stopped := make(chan struct{})
+			go func() {
+				done.Wait()
+				close(stopped)
+			}()
+			select {
+			case <-stopped:
+			case <-wait.Done():
+			}
+			if run.OnStop != nil {
+				return run.OnStop(wait)
+			}
+			return nil
+		},
+	})
+}
+
+// poll claims and delivers the pending record(s) until [ctx] is done.
+// Polls again immediately while the full batch is claimed.
+func (run *outboxRunner[T]) poll(ctx context.Context) {
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		case <-run.Wake: // nil: never
+		}
+		delay := run.PollInterval
+		if run.next(ctx) >= run.Batch {
+			delay = 0 // more pending
+		}
+		timer.Reset(delay)
+	}
+}
+
+// next claims the next batch of the pending record(s)
+// and performs the delivery attempt. Returns the number of record(s) claimed.
+func (run *outboxRunner[T]) next(ctx context.Context) int {
+
+	list, err := run.Claim(ctx, run.Batch)
+	if err != nil {
+		if ctx.Err() == nil {
+			run.Logger.Error("[ " + run.Name + " ] outbox claim; " + err.Error())
+		}
+		return 0
+	}
+
+	if len(list) == 0 {
+		return 0
+	}
+
+	run.Deliver(ctx, list)
+	return len(list)
+}
+
+// purge periodically removes the dead-letter record(s) kept longer than the retention period.
+func (run *outboxRunner[T]) purge(ctx context.Context) {
+
+	timer := time.NewTicker(outboxPurgeInterval)
+	defer timer.Stop()
+
+	for {
+		count, err := run.Purge(ctx, model.LocalTime.Now().Add(-run.Retention))
+		if err != nil && ctx.Err() == nil {
+			run.Logger.Error("[ " + run.Name + " ] outbox purge; " + err.Error())
+		} else if count > 0 {
+			run.Logger.Info("[ "+run.Name+" ] outbox dead-letter(s) purged", "count", count)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+	}
+}
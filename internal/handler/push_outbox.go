@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/webitel/im-account-service/internal/model"
+	"github.com/webitel/im-account-service/internal/push"
+	"github.com/webitel/im-account-service/internal/push/dispatch"
+	"github.com/webitel/im-account-service/internal/store"
+	"go.uber.org/fx"
+)
+
+// StartPushOutbox runs the PUSH delivery outbox worker(s), if enabled.
+// Worker(s) of all the service node(s) compete for the pending record(s).
+func StartPushOutbox(srv *Service, runtime fx.Lifecycle) {
+
+	policy := srv.opts.PushOutbox
+	if policy.Workers <= 0 {
+		return // disabled
+	}
+
+	run := &outboxRunner[*model.PushDelivery]{
+		Name:         "PUSH",
+		Logger:       srv.opts.Logger,
+		Workers:      policy.Workers,
+		Batch:        policy.Batch,
+		PollInterval: policy.PollInterval,
+		Wake:         srv.pushWake,
+		Claim: func(ctx context.Context, size int) ([]*model.PushDelivery, error) {
+			return srv.opts.Outbox.Claim(
+				store.ClaimPushRequest{
+					Context: ctx,
+					Lease:   policy.Lease,
+					Size:    size,
+				},
+			)
+		},
+		Deliver:   srv.deliverPushOutbox,
+		Purge:     srv.opts.Outbox.Purge,
+		Retention: policy.Retention,
+	}
+
+	run.Start(runtime)
+}
+
+// wakePushOutbox signals the (local) idle worker of the NEW record(s) enqueued.
+func (srv *Service) wakePushOutbox() {
+	wakeOutbox(srv.pushWake)
+}
+
+// deliverPushOutbox performs the delivery attempt of the claimed [list] record(s).
+func (srv *Service) deliverPushOutbox(ctx context.Context, list []*model.PushDelivery) {
+
+	policy := srv.opts.PushOutbox
+	var (
+		err     error
+		wg      sync.WaitGroup
+		now     = model.LocalTime.Now()
+		apps    = make(map[string]*model.Application)
+		results = make([]dispatch.Result, len(list))
+	)
+
+	for i, rec := range list {
+		target := dispatch.Target{
+			Session: &model.Authorization{
+				Dc: rec.Dc, Id: rec.SessionId, AppId: rec.AppId,
+			},
+			Token: rec.Token,
+		}
+		// Retry with the remaining TTL only ; dead-letter once elapsed
+		ttl, expired := rec.RemainingTTL(now)
+		if expired {
+			results[i] = dispatch.Result{
+				Target: target, Result: &push.Result{
+					Outcome: push.Failed,
+					Reason:  model.PushExpired,
+					Err:     fmt.Errorf("message TTL %s elapsed", rec.Message.GetTtl().AsDuration()),
+				},
+			}
+			continue
+		}
+		app, ok := apps[rec.AppId]
+		if !ok {
+			app, err = srv.GetApplication(ctx, rec.AppId)
+			if err != nil {
+				results[i] = dispatch.Result{
+					Target: target, Result: push.Failure(err),
+				}
+				continue
+			}
+			if app != nil && app.Authorize() != nil {
+				app = nil // revoked
+			}
+			apps[rec.AppId] = app
+		}
+		target.App = app
+		msg := NotificationMessage(rec.Message)
+		if msg != nil {
+			msg.TTL = ttl
+		}
+		wg.Add(1)
+		go func(dst *dispatch.Result) {
+			defer wg.Done()
+			*dst = srv.opts.Push.Send(
+				ctx, []dispatch.Target{target}, msg,
+			)[0]
+		}(&results[i])
+	}
+
+	wg.Wait()
+
+	var (
+		date    = model.LocalTime.Now()
+		done    []int64
+		update  []*model.PushDelivery
+		expired []dispatch.Result
+		failed  int
+	)
+
+	for i, re := range results {
+		rec := list[i]
+		switch re.Outcome {
+		case push.Delivered:
+			done = append(done, rec.Id)
+			continue
+		case push.Unregistered:
+			done = append(done, rec.Id)
+			expired = append(expired, re)
+			continue
+		}
+		rec.UpdatedAt = date
+		rec.Status = re.Status
+		rec.Reason = re.Reason
+		rec.Error = ""
+		if re.Err != nil {
+			rec.Error = re.Err.Error()
+		}
+		switch {
+		case re.Outcome != push.Retry, rec.Attempts >= policy.MaxAttempts:
+			rec.State = model.PushFailed // dead-letter
+			failed++
+		case ctx.Err() != nil:
+			rec.NextAt = date // shutdown ; release
+		default:
+			rec.NextAt = date.Add(policy.RetryDelay(
+				model.PushRetryClassOf(re.Status), rec.Attempts, re.RetryAfter,
+			))
+		}
+		update = append(update, rec)
+	}
+
+	// Bookkeeping ; even on shutdown
+	ctx = context.WithoutCancel(ctx)
+	if err = srv.opts.Outbox.Delete(ctx, done); err != nil {
+		srv.opts.Logger.Error("[ PUSH ] outbox delete; " + err.Error())
+	}
+	if err = srv.opts.Outbox.Update(ctx, update); err != nil {
+		srv.opts.Logger.Error("[ PUSH ] outbox update; " + err.Error())
+	}
+	srv.expirePushTokens(ctx, expired)
+
+	srv.opts.Logger.Debug(
+		"[ PUSH ] outbox batch",
+		"claimed", len(list),
+		"done", len(done),
+		"retry", len(update)-failed,
+		"failed", failed,
+	)
+}
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/webitel/im-account-service/internal/store"
+	"go.uber.org/fx"
+)
+
+// Maximum number of session(s) affected per sweep statement
+const pushSweepBatch = 1000
+
+// StartPushTokenSweep runs the periodic expiration
+// of the idle session(s) device PUSH token(s), if enabled.
+func StartPushTokenSweep(srv *Service, runtime fx.Lifecycle) {
+
+	policy := srv.opts.PushTokens
+	if policy.IdleTimeout <= 0 || policy.SweepInterval <= 0 {
+		return // disabled
+	}
+
+	var (
+		ctx, stop = context.WithCancel(context.Background())
+		done      = make(chan struct{})
+	)
+
+	runtime.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				defer close(done)
+				timer := time.NewTicker(policy.SweepInterval)
+				defer timer.Stop()
+				for {
+					srv.sweepPushTokens(ctx, time.Now().Add(-policy.IdleTimeout))
+					select {
+					case <-ctx.Done():
+						return
+					case <-timer.C:
+					}
+				}
+			}()
+			return nil
+		},
+		OnStop: func(wait context.Context) error {
+			stop()
+			select {
+			case <-done:
+			case <-wait.Done():
+			}
+			return nil
+		},
+	})
+}
+
+// sweepPushTokens expires device token(s) of the session(s) idle since the given date.
+func (srv *Service) sweepPushTokens(ctx context.Context, idleSince time.Time) {
+
+	var (
+		total int64
+		start = time.Now()
+	)
+
+	for ctx.Err() == nil {
+		count, err := srv.opts.Sessions.ExpirePushTokens(
+			store.ExpirePushTokenRequest{
+				Context:   ctx,
+				IdleSince: idleSince,
+				Size:      pushSweepBatch,
+			},
+		)
+		if err != nil {
+			if ctx.Err() == nil {
+				srv.opts.Logger.Error("[ PUSH ] sweep idle token(s); " + err.Error())
+			}
+			break
+		}
+		total += count
+		if count < pushSweepBatch {
+			break // done
+		}
+	}
+
+	srv.opts.Logger.Info(
+		"[ PUSH ] idle token(s) expired",
+		"sessions", total,
+		"idle_since", idleSince,
+		"spent", time.Since(start),
+	)
+}
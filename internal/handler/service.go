@@ -6,14 +6,16 @@ import (
 	// grpc_srv "github.com/webitel/im-account-service/infra/server/grpc"
 	broker "github.com/webitel/im-account-service/infra/pubsub"
 	auth "github.com/webitel/im-account-service/internal/client/webitel/auth"
+	"github.com/webitel/im-account-service/internal/model"
+	"github.com/webitel/im-account-service/internal/push/dispatch"
 	"github.com/webitel/im-account-service/internal/store"
+	"github.com/webitel/im-account-service/internal/updates"
 	cspb "github.com/webitel/im-account-service/proto/gen/im/service/contact/v1"
 	"go.uber.org/fx"
 )
 
 // Service (Handler) Options
 type ServiceOptions struct {
-
 	fx.In // FX: Params.(input)
 
 	Logger *slog.Logger
@@ -22,6 +24,30 @@ type ServiceOptions struct {
 	// Catalog struct {
 	Apps     store.AppStore
 	Sessions store.SessionStore
+	Secrets  store.AppSecretStore
+	Outbox   store.PushOutboxStore
+	Updates  store.UpdateOutboxStore
+	Events   store.EventOutboxStore
+
+	// App [client_secret] rotation rules
+	SecretPolicy model.ClientSecretPolicy
+
+	// PUSH notification(s) sender
+	Push *dispatch.Dispatcher
+	// Device PUSH token(s) expiration rules
+	PushTokens model.PushTokenPolicy
+	// PUSH delivery outbox worker(s) and retry rules
+	PushOutbox model.PushOutboxPolicy
+
+	// App [service.send_update] and [service.login_hook] endpoint client(s)
+	Webhooks updates.Client
+	Partners updates.BatchClient
+	// App Update(s) delivery outbox worker(s) and retry rules
+	UpdateOutbox model.UpdateOutboxPolicy
+	// Account domain event(s) broker relay
+	EventRelay model.AccountEventPolicy
+	// Session activity tracking rules
+	SessionActivity model.SessionActivityPolicy
 
 	Webitel  *auth.Client
 	Contacts cspb.ContactsClient
@@ -31,11 +57,23 @@ type ServiceOptions struct {
 // Service Handler
 type Service struct {
 	opts ServiceOptions
+	// wakes (local) PUSH outbox worker on enqueue
+	pushWake chan struct{}
+	// wakes (local) Update(s) outbox worker on enqueue
+	updateWake chan struct{}
+	// pending session(s) activity ; coalesced
+	touches sessionTouches
 }
 
 func NewService(opts ServiceOptions) (*Service, error) {
 	return &Service{
-		opts: opts,
+		opts:       opts,
+		pushWake:   make(chan struct{}, 1),
+		updateWake: make(chan struct{}, 1),
+		touches: sessionTouches{
+			pending: make(map[string]*model.SessionActivity),
+			flush:   make(chan struct{}, 1),
+		},
 	}, nil
 }
 
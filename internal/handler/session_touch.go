@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/webitel/im-account-service/internal/model"
+	"go.uber.org/fx"
+)
+
+// sessionTouches buffers the session(s) activity pending to write ;
+// the latest record per session is kept only.
+type sessionTouches struct {
+	mx      sync.Mutex
+	pending map[string]*model.SessionActivity // [Id]
+	// signals the [FlushSize] reached
+	flush chan struct{}
+}
+
+// add the [rec] activity, unless the later one is pending.
+// Returns the number of the session(s) pending.
+func (buf *sessionTouches) add(rec *model.SessionActivity) int {
+	buf.mx.Lock()
+	defer buf.mx.Unlock()
+	if last, ok := buf.pending[rec.Id]; !ok || last.Date.Before(rec.Date) {
+		buf.pending[rec.Id] = rec
+	}
+	return len(buf.pending)
+}
+
+// drain returns all the record(s) pending and resets the buffer.
+func (buf *sessionTouches) drain() []*model.SessionActivity {
+	buf.mx.Lock()
+	defer buf.mx.Unlock()
+	if len(buf.pending) == 0 {
+		return nil
+	}
+	list := make([]*model.SessionActivity, 0, len(buf.pending))
+	for _, rec := range buf.pending {
+		list = append(list, rec)
+	}
+	clear(buf.pending)
+	return list
+}
+
+// TouchSession records the [session] access at [date] from the [device], if due ;
+// See [model.SessionActivityPolicy]. Buffered ; written by the [StartSessionTouch] worker.
+func (srv *Service) TouchSession(ctx context.Context, session *model.Authorization, device *model.Device, date time.Time) {
+
+	policy := srv.opts.SessionActivity
+	activity := policy.Touch(session, device, date)
+	if activity == nil {
+		return // throttled
+	}
+
+	if srv.touches.add(activity) >= policy.FlushSize {
+		select {
+		case srv.touches.flush <- struct{}{}:
+		default: // already signaled
+		}
+	}
+}
+
+// StartSessionTouch runs the session(s) activity buffer writer, if enabled.
+// Pending record(s) are written at the [FlushInterval], or once [FlushSize] reached,
+// and drained on shutdown.
+func StartSessionTouch(srv *Service, runtime fx.Lifecycle) {
+
+	policy := srv.opts.SessionActivity
+	if policy.Interval <= 0 {
+		return // disabled
+	}
+
+	var (
+		ctx, stop = context.WithCancel(context.Background())
+		done      = make(chan struct{})
+	)
+
+	runtime.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				defer close(done)
+				srv.runSessionTouch(ctx)
+			}()
+			return nil
+		},
+		OnStop: func(wait context.Context) error {
+			stop()
+			select {
+			case <-done:
+			case <-wait.Done():
+				return wait.Err()
+			}
+			// Drain ; the record(s) buffered since the last flush,
+			// within the shutdown timeout
+			srv.flushSessionTouch(wait)
+			return nil
+		},
+	})
+}
+
+// runSessionTouch writes the pending record(s) until [ctx] is done.
+func (srv *Service) runSessionTouch(ctx context.Context) {
+
+	timer := time.NewTicker(srv.opts.SessionActivity.FlushInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		case <-srv.touches.flush:
+		}
+		// Write the drained record(s), even on shutdown
+		srv.flushSessionTouch(context.WithoutCancel(ctx))
+	}
+}
+
+// flushSessionTouch writes all the pending record(s) at once.
+// Best effort: the failed record(s) are logged and dropped.
+func (srv *Service) flushSessionTouch(ctx context.Context) {
+
+	list := srv.touches.drain()
+	if len(list) == 0 {
+		return
+	}
+
+	err := srv.opts.Sessions.Touch(ctx, list)
+	if err != nil {
+		srv.opts.Logger.Error(
+			"[ SESSION ] touch; "+err.Error(),
+			"count", len(list),
+		)
+		return
+	}
+
+	srv.opts.Logger.Debug(
+		"[ SESSION ] touch",
+		"count", len(list),
+	)
+}
@@ -0,0 +1,198 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/webitel/im-account-service/internal/model"
+	"github.com/webitel/im-account-service/internal/store"
+	"github.com/webitel/im-account-service/internal/updates"
+	clientpb "github.com/webitel/im-account-service/proto/gen/im/client/v1"
+	adminpb "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+	"go.uber.org/fx"
+)
+
+// StartUpdateOutbox runs the App Update(s) delivery outbox worker(s), if enabled.
+// Worker(s) of all the service node(s) compete for the pending record(s).
+func StartUpdateOutbox(srv *Service, runtime fx.Lifecycle) {
+
+	policy := srv.opts.UpdateOutbox
+	if policy.Workers <= 0 {
+		return // disabled
+	}
+
+	run := &outboxRunner[*model.UpdateDelivery]{
+		Name:         "UPDATE",
+		Logger:       srv.opts.Logger,
+		Workers:      policy.Workers,
+		Batch:        policy.Batch,
+		PollInterval: policy.PollInterval,
+		Wake:         srv.updateWake,
+		Claim: func(ctx context.Context, size int) ([]*model.UpdateDelivery, error) {
+			return srv.opts.Updates.Claim(
+				store.ClaimUpdateRequest{
+					Context: ctx,
+					Lease:   policy.Lease,
+					Size:    size,
+				},
+			)
+		},
+		Deliver:   srv.deliverUpdateOutbox,
+		Purge:     srv.opts.Updates.Purge,
+		Retention: policy.Retention,
+	}
+
+	run.Start(runtime)
+}
+
+// wakeUpdateOutbox signals the (local) idle worker of the NEW record(s) enqueued.
+func (srv *Service) wakeUpdateOutbox() {
+	wakeOutbox(srv.updateWake)
+}
+
+// sendUpdates performs the [list] Update(s) delivery attempt to the [sub] endpoint.
+// Partner service receives the [list] at once ; webhook one by one, concurrently.
+// Result(s) are reported in the [list] order.
+func (srv *Service) sendUpdates(ctx context.Context, sub *adminpb.EventSubscription, list []*clientpb.Update) []*updates.Result {
+
+	timeout := srv.opts.UpdateOutbox.Timeout
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	res := make([]*updates.Result, len(list))
+	switch sub.GetEndpoint().(type) {
+	case *adminpb.EventSubscription_Grpc:
+		if sender := srv.opts.Partners; sender != nil {
+			return sender.SendBatch(ctx, sub, list)
+		}
+	case *adminpb.EventSubscription_Web:
+		if sender := srv.opts.Webhooks; sender != nil {
+			var wg sync.WaitGroup
+			for i, update := range list {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					res[i] = sender.Send(ctx, sub, update)
+				}()
+			}
+			wg.Wait()
+			return res
+		}
+	}
+
+	for i := range res {
+		res[i] = &updates.Result{
+			Err: fmt.Errorf("%T endpoint not supported", sub.GetEndpoint()),
+		}
+	}
+	return res
+}
+
+// deliverUpdateOutbox performs the delivery attempt of the claimed [list] record(s).
+func (srv *Service) deliverUpdateOutbox(ctx context.Context, list []*model.UpdateDelivery) {
+
+	policy := srv.opts.UpdateOutbox
+	var (
+		err     error
+		wg      sync.WaitGroup
+		apps    = make(map[string]*model.Application)
+		results = make([]*updates.Result, len(list))
+		// obsolete ; App revoked or unsubscribed
+		obsolete = make([]bool, len(list))
+		// record(s) index per App subscription
+		batch = make(map[string][]int)
+		subs  = make(map[string]*adminpb.EventSubscription)
+	)
+
+	for i, rec := range list {
+		app, ok := apps[rec.AppId]
+		if !ok {
+			app, err = srv.GetApplication(ctx, rec.AppId)
+			if err != nil {
+				results[i] = updates.Failure(err)
+				continue
+			}
+			if app != nil && app.Authorize() != nil {
+				app = nil // revoked
+			}
+			apps[rec.AppId] = app
+		}
+		// Subscription may have changed since enqueued
+		sub := model.UpdateSubscription(app, rec.Type)
+		if sub == nil {
+			obsolete[i] = true
+			continue
+		}
+		rec.Update.Id = rec.Id
+		subs[rec.AppId] = sub
+		batch[rec.AppId] = append(batch[rec.AppId], i)
+	}
+
+	for appId, index := range batch {
+		sub := subs[appId]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			send := make([]*clientpb.Update, len(index))
+			for n, i := range index {
+				send[n] = list[i].Update
+			}
+			for n, re := range srv.sendUpdates(ctx, sub, send) {
+				results[index[n]] = re
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	var (
+		date   = model.LocalTime.Now()
+		done   []int64
+		update []*model.UpdateDelivery
+		failed int
+	)
+
+	for i, re := range results {
+		rec := list[i]
+		if obsolete[i] || re.Delivered() {
+			done = append(done, rec.Id)
+			continue
+		}
+		rec.UpdatedAt = date
+		rec.Status = re.Status
+		rec.Error = re.Error()
+		switch {
+		case !re.Retry, rec.Attempts >= policy.MaxAttempts:
+			rec.State = model.UpdateFailed // dead-letter
+			failed++
+		case ctx.Err() != nil:
+			rec.NextAt = date // shutdown ; release
+		default:
+			rec.NextAt = date.Add(policy.RetryDelay(
+				model.PushRetryClassOf(re.Status), rec.Attempts, re.RetryAfter,
+			))
+		}
+		update = append(update, rec)
+	}
+
+	// Bookkeeping ; even on shutdown
+	ctx = context.WithoutCancel(ctx)
+	if err = srv.opts.Updates.Delete(ctx, done); err != nil {
+		srv.opts.Logger.Error("[ UPDATE ] outbox delete; " + err.Error())
+	}
+	if err = srv.opts.Updates.Update(ctx, update); err != nil {
+		srv.opts.Logger.Error("[ UPDATE ] outbox update; " + err.Error())
+	}
+
+	srv.opts.Logger.Debug(
+		"[ UPDATE ] outbox batch",
+		"claimed", len(list),
+		"done", len(done),
+		"retry", len(update)-failed,
+		"failed", failed,
+	)
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/webitel/im-account-service/internal/model"
+	clientpb "github.com/webitel/im-account-service/proto/gen/im/client/v1"
+)
+
+// SendUpdate queues the [update] delivery to the [app] subscription endpoint,
+// if subscribed to the event type ; See [model.UpdateSubscription].
+// Delivery is sent by the outbox worker(s) ; See [StartUpdateOutbox].
+// Best effort: the failure is logged, never interrupts the operation.
+func (srv *Service) SendUpdate(ctx context.Context, app *model.Application, update *clientpb.Update) {
+
+	if app == nil || app.Authorize() != nil {
+		return // revoked
+	}
+
+	typeOf := model.UpdateType(update)
+	if model.UpdateSubscription(app, typeOf) == nil {
+		return // not subscribed
+	}
+
+	update.Dc = app.GetDc()
+	update.AppId = app.ClientId()
+	update.Date = model.LocalTime.Now().UnixMilli()
+
+	rec := &model.UpdateDelivery{
+		Dc:        update.Dc,
+		AppId:     update.AppId,
+		ContactId: update.GetContact().GetId(),
+		Type:      typeOf,
+		Update:    update,
+	}
+
+	// Keep the update, even if the operation is canceled
+	err := srv.opts.Updates.Enqueue(
+		context.WithoutCancel(ctx), []*model.UpdateDelivery{rec},
+	)
+
+	if err != nil {
+		srv.opts.Logger.Error(
+			"[ UPDATE ] enqueue; "+err.Error(),
+			"app.id", update.AppId,
+			"event", typeOf,
+		)
+		return
+	}
+
+	srv.wakeUpdateOutbox()
+}
+
+// SendSessionUpdate queues the [update] of the [session] client App, if subscribed.
+// The [app] is resolved of the [session] when not given.
+func (srv *Service) SendSessionUpdate(ctx context.Context, app *model.Application, session *model.Authorization, update *clientpb.Update) {
+
+	if session == nil {
+		return
+	}
+
+	if app == nil || app.ClientId() != session.AppId {
+		var err error
+		app, err = srv.GetApplication(ctx, session.AppId)
+		if err != nil {
+			srv.opts.Logger.Error(
+				"[ UPDATE ] app lookup; "+err.Error(),
+				"app.id", session.AppId,
+			)
+			return
+		}
+	}
+
+	if update.Contact == nil {
+		update.Contact = model.UpdateContact(session.Contact)
+	}
+
+	srv.SendUpdate(ctx, app, update)
+}
+
+// UpdateContactProfile returns the Update representation of the [src] contact profile.
+func UpdateContactProfile(src *model.Contact) *clientpb.Contact {
+	if src == nil {
+		return nil
+	}
+	return &clientpb.Contact{
+		Id:          src.Id,
+		Iss:         src.Iss,
+		Sub:         src.Sub,
+		Name:        src.Name,
+		Username:    src.Username,
+		Email:       src.Email,
+		PhoneNumber: src.PhoneNumber,
+		Locale:      src.Locale,
+		Metadata:    contactMdFormProtoV1(src),
+	}
+}
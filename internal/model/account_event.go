@@ -0,0 +1,61 @@
+package model
+
+import (
+	"time"
+)
+
+// AccountEvent.Type(s) ; Event.Data.(type) ; See [im_account.session_event] trigger.
+const (
+	AccountSignIn           = "sign_in"
+	AccountSignOut          = "sign_out"
+	AccountSessionRevoked   = "session_revoked"
+	AccountSessionUpdated   = "session_updated"
+	AccountPushRegistered   = "push_registered"
+	AccountPushUnregistered = "push_unregistered"
+)
+
+// AccountEvent.Reason of the session_revoked by the App revocation.
+const AccountRevokedApp = "app_revoked"
+
+// AccountEvent is the account domain event outbox record
+// of the session change ; See [eventpb.Event].
+// Recorded within the session change transaction ; published record(s) are removed.
+type AccountEvent struct {
+	Id   int64
+	Dc   int64
+	Type string // Event.Data.(type)
+
+	SessionId string
+	AppId     string
+	Contact   *ContactId // event contact ; signed out for sign_out, session_revoked
+
+	// Session snapshot
+	Name      string
+	IP        string
+	DeviceId  string
+	UserAgent string
+
+	// Device PUSH registration ; push_(un)registered
+	PushType string
+	Push     *PushToken
+	// Revocation reason ; session_revoked
+	Reason string
+
+	Attempts  int // publish attempt(s) made
+	CreatedAt time.Time
+}
+
+// AccountEventPolicy defines the account domain event(s) relay worker.
+type AccountEventPolicy struct {
+	// Publish the event(s) recorded by this node.
+	// Disabled: event(s) are kept until published by the other node(s).
+	Enabled bool
+	// Broker topic exchange to publish to
+	Exchange string
+	// Maximum number of the record(s) claimed at once.
+	Batch int
+	// Interval to poll for the pending record(s), if idle.
+	PollInterval time.Duration
+	// Claimed record(s) lease ; re-claimed after, e.g.: publish failure.
+	Lease time.Duration
+}
@@ -17,8 +17,9 @@ import (
 )
 
 type Mutation struct {
-	Date   time.Time
-	UserId string
+	Date     time.Time
+	UserId   string
+	UserName string
 }
 
 // Application [external] Configuration
@@ -54,6 +55,7 @@ func NewApplication(input *v1.InputApp) *Application {
 		Account:  nil,                // &impb.Account{},
 		Contacts: input.GetContacts(),
 	}
+	setupVAPID(app, nil)
 	return &Application{
 		src: app,
 	}
@@ -193,3 +195,26 @@ func (app *Application) JwtIdentity(message *jws.Message) (idToken *Contact, err
 func (app *Application) AcceptJWT(ctx context.Context, token *jws.Message) (*Contact, error) {
 	return nil, fmt.Errorf("app.AcceptJWT: not implemented yet")
 }
+
+// Revoked returns the Application revocation status, if blocked.
+func (app *Application) Revoked() *v1.Revocation {
+	return app.src.GetBlock()
+}
+
+// Authorize verifies the Application is allowed for use.
+// Returns UNAUTHORIZED_CLIENT error with the revocation reason, if blocked.
+func (app *Application) Authorize() error {
+	block := app.Revoked()
+	if block == nil {
+		// [ OK ]
+		return nil
+	}
+	reason := block.GetReason().GetMessage()
+	if reason == "" {
+		reason = "application revoked"
+	}
+	return errors.Unauthorized(
+		errors.Status("UNAUTHORIZED_CLIENT"),
+		errors.Message("messaging: %s", reason),
+	)
+}
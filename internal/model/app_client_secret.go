@@ -0,0 +1,127 @@
+package model
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/webitel/im-account-service/internal/errors"
+	v1 "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+)
+
+const (
+	// ClientSecretPrefix marks the issued [client_secret] value.
+	ClientSecretPrefix = "wsk_"
+	// ClientSecretSize of random bytes ; 256 bits.
+	ClientSecretSize = 32
+	// Number of the last secret char(s) to keep as a hint.
+	clientSecretHint = 4
+)
+
+// ClientSecretPolicy defines the [client_secret] rotation rules.
+type ClientSecretPolicy struct {
+	// Default overlap window of the current active secret(s) on rotation.
+	Overlap time.Duration
+	// Maximum overlap window allowed to request.
+	MaxOverlap time.Duration
+}
+
+// OverlapOf returns the overlap window for the [given] request value.
+func (rule ClientSecretPolicy) OverlapOf(given time.Duration) (time.Duration, error) {
+	if given <= 0 {
+		return rule.Overlap, nil
+	}
+	if 0 < rule.MaxOverlap && rule.MaxOverlap < given {
+		return 0, errors.BadRequest(
+			errors.Message("app: secret( overlap: %s ); exceeds maximum of %s", given, rule.MaxOverlap),
+		)
+	}
+	return given, nil
+}
+
+// ErrClientSecret signals invalid [client_secret] credentials.
+var ErrClientSecret = errors.Unauthorized(
+	errors.Status("INVALID_CLIENT"),
+	errors.Message("app: invalid client_secret credentials"),
+)
+
+// ClientSecret of the Application.
+// Only the [Hash] of the [Secret] is ever stored.
+type ClientSecret struct {
+	Dc    int64  // domain_id
+	AppId string // client_id
+	Id    string
+
+	Hash []byte // SHA-256 ; of the [Secret]
+	Hint string // last few char(s) of the [Secret]
+
+	// Plaintext value ; NEW issue ONLY !
+	Secret string
+
+	CreatedAt  time.Time
+	ExpiresAt  *time.Time // overlap window end
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+}
+
+// NewClientSecret generates a NEW strong random [client_secret] for the [app].
+func NewClientSecret(app *Application, date time.Time) (*ClientSecret, error) {
+	data := make([]byte, ClientSecretSize)
+	_, err := rand.Read(data)
+	if err != nil {
+		return nil, err
+	}
+	secret := ClientSecretPrefix + base64.RawURLEncoding.EncodeToString(data)
+	return &ClientSecret{
+		Dc:        app.GetDc(),
+		AppId:     app.ClientId(),
+		Id:        uuid.NewString(),
+		Hash:      ClientSecretHash(secret),
+		Hint:      secret[len(secret)-clientSecretHint:],
+		Secret:    secret,
+		CreatedAt: date,
+	}, nil
+}
+
+// ClientSecretHash returns the [secret] value hash to be stored or matched.
+func ClientSecretHash(secret string) []byte {
+	sum := sha256.Sum256([]byte(secret))
+	return sum[:]
+}
+
+// Match reports whether the plaintext [secret] matches the stored hash.
+func (s *ClientSecret) Match(secret string) bool {
+	return subtle.ConstantTimeCompare(s.Hash, ClientSecretHash(secret)) == 1
+}
+
+// Active reports whether the secret is valid at the given [date].
+func (s *ClientSecret) Active(date time.Time) bool {
+	if s.RevokedAt != nil {
+		return false
+	}
+	return s.ExpiresAt == nil || date.Before(*s.ExpiresAt)
+}
+
+// Proto returns the (admin) representation.
+// Plaintext [Secret] is present only on issue.
+func (s *ClientSecret) Proto() *v1.ClientSecret {
+	res := &v1.ClientSecret{
+		Id:        s.Id,
+		Hint:      s.Hint,
+		Secret:    s.Secret,
+		CreatedAt: Timestamp.Time(s.CreatedAt),
+	}
+	if s.ExpiresAt != nil {
+		res.ExpiresAt = Timestamp.Time(*s.ExpiresAt)
+	}
+	if s.LastUsedAt != nil {
+		res.LastUsedAt = Timestamp.Time(*s.LastUsedAt)
+	}
+	if s.RevokedAt != nil {
+		res.RevokedAt = Timestamp.Time(*s.RevokedAt)
+	}
+	return res
+}
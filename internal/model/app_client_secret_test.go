@@ -0,0 +1,48 @@
+package model
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+)
+
+func TestClientSecret(test *testing.T) {
+
+	date := time.Now()
+	app := ProtoApplication(&v1.Application{
+		Dc: 1, Id: "5e9ad1c9-7f3a-4f0e-9f7e-2f1c1a0b6c11",
+	})
+
+	secret, err := NewClientSecret(app, date)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	if !strings.HasPrefix(secret.Secret, ClientSecretPrefix) || !strings.HasSuffix(secret.Secret, secret.Hint) {
+		test.Errorf("NewClientSecret() = %q ; hint %q", secret.Secret, secret.Hint)
+	}
+	if !secret.Match(secret.Secret) || secret.Match(secret.Secret+"x") {
+		test.Error("ClientSecret.Match() mismatch")
+	}
+
+	other, _ := NewClientSecret(app, date)
+	if other.Secret == secret.Secret {
+		test.Error("NewClientSecret() is not random")
+	}
+
+	expires := date.Add(time.Hour)
+	secret.ExpiresAt = &expires
+	if !secret.Active(date) || secret.Active(expires) {
+		test.Error("ClientSecret.Active() overlap window mismatch")
+	}
+
+	rule := ClientSecretPolicy{Overlap: time.Hour, MaxOverlap: 24 * time.Hour}
+	if overlap, _ := rule.OverlapOf(0); overlap != time.Hour {
+		test.Errorf("OverlapOf( 0 ) = %s, want %s", overlap, time.Hour)
+	}
+	if _, err = rule.OverlapOf(48 * time.Hour); err == nil {
+		test.Error("OverlapOf( 48h ) error = nil, want failure")
+	}
+}
@@ -0,0 +1,134 @@
+package model
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/webitel/im-account-service/internal/errors"
+	"github.com/webitel/im-account-service/internal/graphql"
+	v1 "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// AppSortFields available to order the Application(s) list by.
+var AppSortFields = []string{
+	"id", "name", "about", "ver", "created_at", "updated_at",
+}
+
+// AppMetadata of the Application (admin) output fields.
+// Nested message field(s) MAY be selected, e.g.: "client{ua},service{push_service}".
+var AppMetadata = appMetadata(
+	(*v1.Application)(nil).ProtoReflect().Descriptor(), "app",
+)
+
+// appMetadata describes the [msg] field(s) as the [name] object.
+// Top-level default set is ALL the field(s).
+func appMetadata(msg protoreflect.MessageDescriptor, name string) *graphql.Metadata {
+	md := &graphql.Metadata{
+		Name: name,
+		Type: string(msg.FullName()),
+	}
+	fields := msg.Fields()
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+		node := &graphql.Metadata{
+			Name: string(fd.Name()),
+			Type: fd.Kind().String(),
+		}
+		if sub := fd.Message(); sub != nil && !fd.IsList() && !fd.IsMap() &&
+			!strings.HasPrefix(string(sub.FullName()), "google.") {
+			// Nested (config) section
+			node = appMetadata(sub, node.Name)
+		}
+		md.Fields = append(md.Fields, node)
+	}
+	if name == "app" {
+		for _, fd := range md.Fields {
+			md.Default = append(md.Default, fd.Name)
+		}
+	}
+	return md
+}
+
+// ParseAppFields validates the Application output [fields] selection.
+// Empty: ALL the field(s).
+func ParseAppFields(fields []string) (graphql.Fields, error) {
+	res, err := AppMetadata.ParseFields(
+		fields, graphql.NoArgs(),
+	)
+	if err != nil {
+		return nil, errors.BadRequest(
+			errors.Status("BAD_FIELDS"),
+			errors.Message("app: fields( %s ); %v", strings.Join(fields, ","), errorMessage(err)),
+		)
+	}
+	return res, nil
+}
+
+// ParseAppSort validates the Application(s) list [sort] order.
+// Field name prefix: "+" ascending (default) ; "-" or "!" descending.
+func ParseAppSort(sort []string) (graphql.Fields, error) {
+	res, err := graphql.ParseFieldsQuery(
+		sort, graphql.Sorting(), graphql.NoNested(),
+	)
+	for _, q := range res {
+		if err != nil {
+			break
+		}
+		name := strings.TrimLeft(q.Name, "+-!")
+		if name == "" || !slices.Contains(AppSortFields, name) {
+			err = errors.BadRequest(
+				errors.Message("field %q is not sortable", q.Name),
+			)
+		}
+	}
+	if err != nil {
+		return nil, errors.BadRequest(
+			errors.Status("BAD_SORT"),
+			errors.Message("app: sort( %s ); %v", strings.Join(sort, ","), errorMessage(err)),
+		)
+	}
+	return res, nil
+}
+
+// Select returns the Application [fields] projection.
+// Field, selected with no nested field(s), is copied as a whole.
+func (app *Application) Select(fields graphql.Fields) *v1.Application {
+	if len(fields) == 0 {
+		return app.Proto()
+	}
+	dst := &v1.Application{}
+	selectFields(dst.ProtoReflect(), app.Proto().ProtoReflect(), fields)
+	return dst
+}
+
+// SelectApp returns the [src] App [fields] projection.
+func SelectApp(src *v1.Application, fields graphql.Fields) *v1.Application {
+	if len(fields) == 0 {
+		return src
+	}
+	return (&Application{src: src}).Select(fields)
+}
+
+// selectFields copies the [src] message [fields] value(s) into [dst].
+func selectFields(dst, src protoreflect.Message, fields graphql.Fields) {
+	for _, q := range fields {
+		fd := src.Descriptor().Fields().ByName(protoreflect.Name(q.Name))
+		if fd == nil || !src.Has(fd) {
+			continue
+		}
+		if len(q.Fields) > 0 && fd.Message() != nil && !fd.IsList() && !fd.IsMap() {
+			selectFields(dst.Mutable(fd).Message(), src.Get(fd).Message(), q.Fields)
+			continue
+		}
+		dst.Set(fd, src.Get(fd))
+	}
+}
+
+// errorMessage returns the [err] message text, with no code and status.
+func errorMessage(err error) string {
+	if re, ok := err.(*errors.Error); ok && re.Message != "" {
+		return re.Message
+	}
+	return err.Error()
+}
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"testing"
+
+	v1 "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestApplicationSelect(test *testing.T) {
+
+	fields, err := ParseAppFields([]string{"id,name,client{ua},service{push_service{fcm}}"})
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	app := ProtoApplication(&v1.Application{
+		Id:    "client_id",
+		Name:  "App",
+		About: "About",
+		Client: &v1.ClientApp{
+			Ua:  []string{"Mozilla/*"},
+			Net: &v1.ClientNet{Cidr: []string{"10.0.0.0/8"}},
+		},
+		Service: &v1.ServiceApp{
+			Secret: "secret",
+			PushService: &v1.PUSHServiceClient{
+				Fcm: &v1.PushFCMServiceClient{Account: []byte("{}")},
+			},
+		},
+	})
+
+	want := &v1.Application{
+		Id:     "client_id",
+		Name:   "App",
+		Client: &v1.ClientApp{Ua: []string{"Mozilla/*"}},
+		Service: &v1.ServiceApp{
+			PushService: &v1.PUSHServiceClient{
+				Fcm: &v1.PushFCMServiceClient{Account: []byte("{}")},
+			},
+		},
+	}
+
+	if got := app.Select(fields); !proto.Equal(got, want) {
+		test.Errorf("app.Select( %s ) = %v, want %v", fields, got, want)
+	}
+
+	if _, err = ParseAppFields([]string{"client{unknown}"}); err == nil {
+		test.Error("ParseAppFields( client{unknown} ) error = nil, want failure")
+	}
+
+	if _, err = ParseAppSort([]string{"!updated_at,name"}); err != nil {
+		test.Errorf("ParseAppSort() error = %v", err)
+	}
+	if _, err = ParseAppSort([]string{"client"}); err == nil {
+		test.Error("ParseAppSort( client ) error = nil, want failure")
+	}
+}
@@ -0,0 +1,132 @@
+package model
+
+import (
+	v1 "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Application change operation(s)
+const (
+	AppCreate  = "create"
+	AppUpdate  = "update"
+	AppRestore = "restore"
+	AppRevoke  = "revoke"
+)
+
+// AppVersion is the Application configuration snapshot
+// stored on every change, with the author and diff summary.
+type AppVersion struct {
+	Ver      int32
+	Op       string   // create, update, restore, revoke
+	Diff     []string // field path(s) changed
+	Restored int32    // restored from [ver]sion ; op: restore
+	Mutation          // Date, UserId ; author
+	App      *Application
+}
+
+type AppVersionList = Dataset[AppVersion]
+
+// Proto returns the (admin) representation ; secrets masked.
+func (v *AppVersion) Proto() *v1.AppVersion {
+	res := &v1.AppVersion{
+		Ver:      v.Ver,
+		Op:       v.Op,
+		Date:     Timestamp.Time(v.Date),
+		Diff:     v.Diff,
+		Restored: v.Restored,
+	}
+	if v.UserId != "" || v.UserName != "" {
+		res.By = &v1.AppChanger{
+			Id:   v.UserId,
+			Name: v.UserName,
+		}
+	}
+	if v.App != nil {
+		res.App = v.App.Masked()
+	}
+	return res
+}
+
+// Restore returns NEW Application configuration
+// with all the updatable section(s) of the [from] snapshot.
+// Record-level field(s), e.g.: [ver], [block], are kept current.
+func (app *Application) Restore(from *Application) *Application {
+	dst := app.Proto()
+	src := from.src.ProtoReflect()
+	for _, name := range appUpdateFields {
+		fd := src.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if src.Has(fd) {
+			dst.ProtoReflect().Set(fd, src.Get(fd))
+		} else {
+			dst.ProtoReflect().Clear(fd)
+		}
+	}
+	setupVAPID(dst, app.src)
+	return &Application{src: proto.CloneOf(dst)}
+}
+
+// AppDiff returns the updatable field path(s) that differ
+// between the [prev] and [next] App configuration(s).
+// Nil [prev] reports all the [next] section(s) set.
+func AppDiff(prev, next *Application) []string {
+	var (
+		diff     []string
+		src, dst protoreflect.Message
+		fields   = (*v1.Application)(nil).ProtoReflect().Descriptor().Fields()
+	)
+	if prev != nil {
+		src = prev.src.ProtoReflect()
+	}
+	if next != nil {
+		dst = next.src.ProtoReflect()
+	}
+	for _, name := range appUpdateFields {
+		fd := fields.ByName(protoreflect.Name(name))
+		diff = diffField(diff, name, fd, src, dst)
+	}
+	return diff
+}
+
+// diffField appends the [path] of the [fd] field, or its nested field(s),
+// which value differ between the [src] and [dst] message(s) ; MAY be nil.
+func diffField(diff []string, path string, fd protoreflect.FieldDescriptor, src, dst protoreflect.Message) []string {
+
+	sok := src != nil && src.Has(fd)
+	dok := dst != nil && dst.Has(fd)
+	if !sok && !dok {
+		return diff // both unset
+	}
+	if sok != dok {
+		return append(diff, path)
+	}
+
+	sv, dv := src.Get(fd), dst.Get(fd)
+	if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+		if !sv.Equal(dv) {
+			diff = append(diff, path)
+		}
+		return diff
+	}
+
+	if proto.Equal(sv.Message().Interface(), dv.Message().Interface()) {
+		return diff
+	}
+
+	n := len(diff)
+	fields := fd.Message().Fields()
+	for i := range fields.Len() {
+		nested := fields.Get(i)
+		diff = diffField(diff,
+			path+"."+string(nested.Name()), nested,
+			sv.Message(), dv.Message(),
+		)
+	}
+
+	if len(diff) == n {
+		// e.g.: unknown field(s) differ
+		diff = append(diff, path)
+	}
+
+	return diff
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"slices"
+	"testing"
+
+	v1 "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+)
+
+func TestApplicationDiff(test *testing.T) {
+
+	prev := ProtoApplication(&v1.Application{
+		Id:   "client_id",
+		Ver:  3,
+		Name: "App",
+		Service: &v1.ServiceApp{
+			PushService: &v1.PUSHServiceClient{
+				Fcm: &v1.PushFCMServiceClient{Account: []byte("v1")},
+			},
+		},
+	})
+
+	next, err := prev.Update(&v1.InputApp{
+		Name:  "App",
+		About: "About",
+		Service: &v1.ServiceApp{
+			PushService: &v1.PUSHServiceClient{
+				Fcm: &v1.PushFCMServiceClient{Account: []byte("v2")},
+			},
+		},
+	}, nil)
+
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	diff := AppDiff(prev, next)
+	want := []string{"about", "service.push_service.fcm.account"}
+	if !slices.Equal(diff, want) {
+		test.Errorf("AppDiff() = %v, want %v", diff, want)
+	}
+
+	back := next.Restore(prev)
+	if diff = AppDiff(prev, back); len(diff) > 0 {
+		test.Errorf("AppDiff( restored ) = %v, want none", diff)
+	}
+	if back.Version() != next.Version() || back.ClientId() != prev.ClientId() {
+		test.Errorf("Restore() = ver( %d ), want ver( %d )", back.Version(), next.Version())
+	}
+}
@@ -0,0 +1,125 @@
+package model
+
+import (
+	"bytes"
+	"strings"
+
+	v1 "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Application secret field(s) path.
+// Encrypted at rest and masked in the admin API output.
+var AppSecretFields = []string{
+	"service.secret",
+	"service.send_update.token",
+	"service.send_update.secret",
+	"service.login_hook.token",
+	"service.login_hook.secret",
+	"service.push_service.web.token",
+	"service.push_service.web.vapid.private_key",
+	"service.push_service.fcm.account",
+	"service.push_service.apn.token.auth_key",
+	"service.push_service.apn.tls.pkey",
+}
+
+// SecretMask replaces the secret value in the admin API output.
+// Input of the same value on update keeps the current secret unchanged.
+const SecretMask = "********"
+
+// AppSecrets returns the [src] App secret field(s) value(s) set, by path.
+// If [clear], the field(s) are cleared from the [src].
+func AppSecrets(src *v1.Application, clear bool) map[string][]byte {
+	data := make(map[string][]byte)
+	for _, path := range AppSecretFields {
+		msg, fd := secretField(src.ProtoReflect(), path, false)
+		if msg == nil || !msg.Has(fd) {
+			continue
+		}
+		switch vs := msg.Get(fd).Interface().(type) {
+		case string:
+			data[path] = []byte(vs)
+		case []byte:
+			data[path] = bytes.Clone(vs)
+		}
+		if clear {
+			msg.Clear(fd)
+		}
+	}
+	return data
+}
+
+// SetAppSecrets assigns the [src] App secret field(s) [data], by path.
+func SetAppSecrets(src *v1.Application, data map[string][]byte) {
+	for path, value := range data {
+		msg, fd := secretField(src.ProtoReflect(), path, true)
+		if msg == nil {
+			continue
+		}
+		switch fd.Kind() {
+		case protoreflect.StringKind:
+			msg.Set(fd, protoreflect.ValueOfString(string(value)))
+		case protoreflect.BytesKind:
+			msg.Set(fd, protoreflect.ValueOfBytes(value))
+		}
+	}
+}
+
+// Masked returns the Application (admin) representation
+// with secret value(s) replaced with the [SecretMask].
+func (app *Application) Masked() *v1.Application {
+	dst := app.Proto()
+	data := AppSecrets(dst, true)
+	for path := range data {
+		data[path] = []byte(SecretMask)
+	}
+	SetAppSecrets(dst, data)
+	return dst
+}
+
+// keepSecrets restores the [src] secret value(s)
+// for the [dst] field(s) set with the [SecretMask] input.
+func keepSecrets(dst, src *v1.Application) {
+	keep := AppSecrets(src, false)
+	data := AppSecrets(dst, false)
+	for path, value := range data {
+		if string(value) != SecretMask {
+			continue // NEW value
+		}
+		if prev, ok := keep[path]; ok {
+			data[path] = prev
+		} else {
+			delete(data, path)
+			msg, fd := secretField(dst.ProtoReflect(), path, false)
+			msg.Clear(fd)
+		}
+	}
+	SetAppSecrets(dst, data)
+}
+
+// secretField resolves the [path] field descriptor within the [src] message.
+// If [mutable], intermediate message(s) are populated; otherwise MAY return nil.
+func secretField(src protoreflect.Message, path string, mutable bool) (protoreflect.Message, protoreflect.FieldDescriptor) {
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		fd := src.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			return nil, nil
+		}
+		if i == len(names)-1 {
+			return src, fd
+		}
+		if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+			return nil, nil
+		}
+		if !mutable {
+			if !src.Has(fd) {
+				return nil, nil
+			}
+			src = src.Get(fd).Message()
+			continue
+		}
+		src = src.Mutable(fd).Message()
+	}
+	return nil, nil
+}
@@ -0,0 +1,102 @@
+package model
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/webitel/im-account-service/internal/errors"
+	v1 "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Application (top-level) section(s) available for update.
+// Default update mask, if none specified.
+var appUpdateFields = []string{
+	"name", "about", "client", "service", "account", "contacts",
+}
+
+// ErrAppModified signals that the Application has been modified
+// since the [ver]sion the update was based on.
+var ErrAppModified = errors.New(
+	errors.Code(http.StatusPreconditionFailed),
+	errors.Status("APP_MODIFIED"),
+	errors.Message("app: configuration has been modified; reload and try again"),
+)
+
+// Version of the Application configuration.
+func (app *Application) Version() int32 {
+	return app.src.GetVer()
+}
+
+// Update returns NEW Application configuration
+// with [input] field(s) listed in the [mask] applied.
+// Empty [mask] replaces all the updatable section(s).
+//
+// Path may refer to the nested field, e.g.: "service.push_service.fcm".
+// Field that is missing in the [input] is cleared.
+func (app *Application) Update(input *v1.InputApp, mask *fieldmaskpb.FieldMask) (*Application, error) {
+
+	paths := mask.GetPaths()
+	if len(paths) == 0 {
+		paths = appUpdateFields
+	}
+
+	dst := app.Proto()
+	src := input.ProtoReflect()
+	for _, path := range paths {
+		fields := strings.Split(path, ".")
+		if !slices.Contains(appUpdateFields, fields[0]) {
+			return nil, errors.BadRequest(
+				errors.Status("BAD_UPDATE_MASK"),
+				errors.Message("app: update_mask( %s ); field not updatable", path),
+			)
+		}
+		err := updateField(dst.ProtoReflect(), src, fields)
+		if err != nil {
+			return nil, errors.BadRequest(
+				errors.Status("BAD_UPDATE_MASK"),
+				errors.Message("app: update_mask( %s ); %v", path, err),
+			)
+		}
+	}
+
+	// Input of the [SecretMask] keeps current secret(s)
+	keepSecrets(dst, app.src)
+	// Web PUSH key pair, unless replaced
+	setupVAPID(dst, app.src)
+
+	return &Application{src: dst}, nil
+}
+
+// updateField copies [src] field value, at the given [path], into [dst].
+func updateField(dst, src protoreflect.Message, path []string) error {
+
+	name := protoreflect.Name(path[0])
+	dfd := dst.Descriptor().Fields().ByName(name)
+	sfd := src.Descriptor().Fields().ByName(name)
+	if dfd == nil || sfd == nil {
+		return fmt.Errorf("field %q not found", name)
+	}
+
+	if len(path) == 1 {
+		if src.Has(sfd) {
+			dst.Set(dfd, src.Get(sfd))
+		} else {
+			dst.Clear(dfd)
+		}
+		return nil
+	}
+
+	if sfd.Message() == nil || sfd.IsList() || sfd.IsMap() {
+		return fmt.Errorf("field %q is not a message", name)
+	}
+
+	return updateField(
+		dst.Mutable(dfd).Message(),
+		src.Get(sfd).Message(),
+		path[1:],
+	)
+}
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"testing"
+
+	v1 "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestApplicationUpdate(test *testing.T) {
+
+	app := ProtoApplication(&v1.Application{
+		Dc:    1,
+		Id:    "client_id",
+		Name:  "App",
+		About: "About",
+		Ver:   3,
+		Contacts: &v1.ContactApp{
+			Auth: &v1.IdentityProvider{Issuers: []string{"old"}},
+		},
+	})
+
+	res, err := app.Update(
+		&v1.InputApp{
+			Name: "NEW",
+			Contacts: &v1.ContactApp{
+				Auth: &v1.IdentityProvider{Issuers: []string{"new"}},
+			},
+		},
+		&fieldmaskpb.FieldMask{Paths: []string{"name", "contacts.auth.issuers"}},
+	)
+
+	if err != nil {
+		test.Fatalf("app.Update() error = %v", err)
+	}
+
+	src := res.Proto()
+	if src.GetName() != "NEW" || src.GetAbout() != "About" {
+		test.Errorf("app.Update() = name: %q, about: %q; want: name: NEW, about: About", src.GetName(), src.GetAbout())
+	}
+	if got := src.GetContacts().GetAuth().GetIssuers(); len(got) != 1 || got[0] != "new" {
+		test.Errorf("app.Update().contacts.auth.issuers = %v, want [new]", got)
+	}
+	if src.GetId() != "client_id" || src.GetVer() != 3 {
+		test.Errorf("app.Update() = id: %q, ver: %d; want: unchanged", src.GetId(), src.GetVer())
+	}
+
+	_, err = app.Update(
+		&v1.InputApp{Dc: 2},
+		&fieldmaskpb.FieldMask{Paths: []string{"dc"}},
+	)
+	if err == nil {
+		test.Errorf("app.Update( dc ) error = nil, want BAD_UPDATE_MASK")
+	}
+}
@@ -0,0 +1,421 @@
+package model
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/webitel/im-account-service/infra/state"
+	"github.com/webitel/im-account-service/internal/errors"
+	"github.com/webitel/im-account-service/internal/push/apns"
+	v1 "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+)
+
+// Validate the Application configuration semantics.
+// Returns (#400) BAD_REQUEST error with all the field-level violations found.
+func (app *Application) Validate() error {
+	var (
+		src  = app.src
+		errs errors.Violations
+	)
+	validateAppName(&errs, src)
+	validateClientApp(&errs, "client", src.GetClient())
+	validateServiceApp(&errs, "service", src.GetService())
+	validateContactApp(&errs, "contacts", src.GetContacts())
+	return errs.Err(
+		errors.Status("BAD_APP_CONFIG"),
+	)
+}
+
+const maxAppNameLen = 255
+
+func validateAppName(errs *errors.Violations, src *v1.Application) {
+	name := strings.TrimSpace(src.GetName())
+	switch {
+	case name == "":
+		errs.Add("name", "required")
+	case utf8.RuneCountInString(name) > maxAppNameLen:
+		errs.Add("name", "too long; max %d characters", maxAppNameLen)
+	}
+}
+
+func validateClientApp(errs *errors.Violations, field string, src *v1.ClientApp) {
+	if src == nil {
+		return
+	}
+	for i, expr := range src.GetUa() {
+		if _, err := regexp.Compile(expr); err != nil {
+			errs.Add(fmt.Sprintf("%s.ua[%d]", field, i), "invalid pattern; %v", err)
+		}
+	}
+	for i, cidr := range src.GetNet().GetCidr() {
+		if _, err := netip.ParsePrefix(cidr); err != nil {
+			if _, re := netip.ParseAddr(cidr); re != nil {
+				errs.Add(fmt.Sprintf("%s.net.cidr[%d]", field, i), "invalid network %q", cidr)
+			}
+		}
+	}
+	for i, origin := range src.GetWeb().GetOrigin() {
+		if err := validateOrigin(origin); err != nil {
+			errs.Add(fmt.Sprintf("%s.web.origin[%d]", field, i), "%v", err)
+		}
+	}
+	if src.GetMaxUsage() < 0 {
+		errs.Add(field+".max_usage", "negative value")
+	}
+	if idle := src.GetMaxIdle(); idle != 0 && (idle < 1 || idle > 1440) {
+		errs.Add(field+".max_idle", "out of range [1..1440] minutes")
+	}
+	if src.GetMaxAge() < 0 {
+		errs.Add(field+".max_age", "negative value")
+	}
+}
+
+// validateOrigin pattern, e.g.: "*", "https://example.com", "https://*.example.com:8443"
+func validateOrigin(origin string) error {
+	if origin == "*" {
+		return nil
+	}
+	scheme, host, ok := strings.Cut(origin, "://")
+	if !ok || (scheme != "http" && scheme != "https") {
+		return fmt.Errorf("invalid origin %q; scheme http(s):// required", origin)
+	}
+	if host == "" || strings.ContainsAny(host, "/?#@ ") {
+		return fmt.Errorf("invalid origin %q; [scheme://]host[:port] expected", origin)
+	}
+	if wild := strings.Count(host, "*"); wild > 1 || (wild == 1 && !strings.HasPrefix(host, "*.")) {
+		return fmt.Errorf("invalid origin %q; wildcard allowed as the leftmost label only", origin)
+	}
+	if _, err := url.Parse(scheme + "://" + strings.Replace(host, "*", "x", 1)); err != nil {
+		return fmt.Errorf("invalid origin %q", origin)
+	}
+	return nil
+}
+
+func validateServiceApp(errs *errors.Violations, field string, src *v1.ServiceApp) {
+	if src == nil {
+		return
+	}
+	validateRateLimits(errs, field+".rate_limits", src.GetRateLimits())
+	validateSendUpdate(errs, field+".send_update", src.GetSendUpdate())
+	validatePushService(errs, field+".push_service", src.GetPushService())
+	validateLoginHook(errs, field+".login_hook", src.GetLoginHook())
+}
+
+func validateRateLimits(errs *errors.Violations, field string, src *v1.RateLimiter) {
+	if src == nil {
+		return
+	}
+	for name, zone := range src.GetZone() {
+		at := fmt.Sprintf("%s.zone[%s]", field, name)
+		switch zone.GetAlgo() {
+		case "", "fixed_window", "token_bucket":
+		default:
+			errs.Add(at+".algo", "unknown algorithm %q; expect: fixed_window, token_bucket", zone.GetAlgo())
+		}
+		if _, err := state.ParseLimit(zone.GetRate(), 0); err != nil {
+			errs.Add(at+".rate", "%v", err)
+		}
+	}
+	for path, group := range src.GetPath() {
+		for name := range group.GetZone() {
+			if _, ok := src.GetZone()[name]; !ok {
+				errs.Add(fmt.Sprintf("%s.path[%s].zone[%s]", field, path, name), "zone not defined")
+			}
+		}
+	}
+}
+
+// [A-Za-z0-9_-]{1,256}
+var eventTokenRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]{1,256}$`)
+
+func validateSendUpdate(errs *errors.Violations, field string, src *v1.EventSubscription) {
+	if src == nil {
+		return
+	}
+	switch endpoint := src.GetEndpoint().(type) {
+	case *v1.EventSubscription_Web:
+		validateWebhook(errs, field+".web", endpoint.Web)
+	case *v1.EventSubscription_Grpc:
+		validateGrpcService(errs, field+".grpc", endpoint.Grpc)
+	case nil:
+		errs.Add(field, "endpoint (web|grpc) required")
+	}
+	if token := src.GetToken(); token != "" && !eventTokenRegexp.MatchString(token) {
+		errs.Add(field+".token", "1-256 characters of [A-Za-z0-9_-] allowed")
+	}
+	validateSigningSecret(errs, field, src.GetSecret(), src.GetToken())
+	for i, event := range src.GetEvents() {
+		if !slices.Contains(updateTypes, event) {
+			errs.Add(fmt.Sprintf("%s.events[%d]", field, i), "unknown event %q", event)
+		}
+	}
+}
+
+func validateLoginHook(errs *errors.Violations, field string, src *v1.LoginHook) {
+	if src == nil {
+		return
+	}
+	switch endpoint := src.GetEndpoint().(type) {
+	case *v1.LoginHook_Web:
+		validateWebhook(errs, field+".web", endpoint.Web)
+	case *v1.LoginHook_Grpc:
+		validateGrpcService(errs, field+".grpc", endpoint.Grpc)
+	case nil:
+		errs.Add(field, "endpoint (web|grpc) required")
+	}
+	if token := src.GetToken(); token != "" && !eventTokenRegexp.MatchString(token) {
+		errs.Add(field+".token", "1-256 characters of [A-Za-z0-9_-] allowed")
+	}
+	validateSigningSecret(errs, field, src.GetSecret(), src.GetToken())
+	if timeout := src.GetTimeout(); timeout != 0 && (timeout < 100 || timeout > 30000) {
+		errs.Add(field+".timeout", "100..30000 (ms) allowed")
+	}
+}
+
+// validateSigningSecret checks the webhook request(s) signing [secret], if set.
+// The [secret] MUST differ from the [token] sent in clear.
+func validateSigningSecret(errs *errors.Violations, field, secret, token string) {
+	if secret == "" {
+		return
+	}
+	if n := len(secret); n < 16 || n > 256 {
+		errs.Add(field+".secret", "16-256 characters allowed")
+	} else if secret == token {
+		errs.Add(field+".secret", "must differ from the token")
+	}
+}
+
+func validateWebhook(errs *errors.Violations, field string, hook *v1.WebhookSubscription) {
+	if err := validateURL(hook.GetUrl(), "https"); err != nil {
+		errs.Add(field+".url", "%v", err)
+	}
+	if addr := hook.GetAddr(); addr != "" {
+		if _, err := netip.ParseAddr(addr); err != nil {
+			errs.Add(field+".addr", "invalid IP address %q", addr)
+		}
+	}
+}
+
+func validateGrpcService(errs *errors.Violations, field string, host *v1.GrpcServiceSubscription) {
+	if host.GetHost() == "" {
+		errs.Add(field+".host", "required")
+	} else if _, _, err := UpdateServiceTarget(host.GetHost()); err != nil {
+		errs.Add(field+".host", "%v", err)
+	}
+	if addr := host.GetAddr(); addr != "" {
+		if _, err := netip.ParseAddr(addr); err != nil {
+			errs.Add(field+".addr", "invalid IP address %q", addr)
+		}
+	}
+}
+
+func validatePushService(errs *errors.Violations, field string, src *v1.PUSHServiceClient) {
+	if src == nil {
+		return
+	}
+	if web := src.GetWeb(); web != nil {
+		if proxy := web.GetProxy(); proxy != "" {
+			if err := validateURL(proxy, "http", "https"); err != nil {
+				errs.Add(field+".web.proxy", "%v", err)
+			}
+		}
+		validateVAPID(errs, field+".web.vapid", web.GetVapid())
+	}
+	if fcm := src.GetFcm(); fcm != nil {
+		if proxy := fcm.GetProxy(); proxy != "" {
+			if err := validateURL(proxy, "http", "https"); err != nil {
+				errs.Add(field+".fcm.proxy", "%v", err)
+			}
+		}
+		if err := validateFCMAccount(fcm.GetAccount()); err != nil {
+			errs.Add(field+".fcm.account", "%v", err)
+		}
+	}
+	if apn := src.GetApn(); apn != nil {
+		validateAPNService(errs, field+".apn", apn)
+	}
+	for id, template := range src.GetTemplates() {
+		validatePushTemplate(errs, fmt.Sprintf("%s.templates[%s]", field, id), id, template)
+	}
+}
+
+func validatePushTemplate(errs *errors.Violations, field, id string, src *v1.NotificationTemplate) {
+	if !pushTemplateRegexp.MatchString(id) {
+		errs.Add(field, "invalid template ID; 1-64 characters of [A-Za-z0-9_.-] allowed")
+	}
+	if src.GetTitle() == "" && src.GetBody() == "" {
+		errs.Add(field, "default title or body required")
+	}
+	for tag := range src.GetLocales() {
+		if !pushLocaleRegexp.MatchString(tag) {
+			errs.Add(fmt.Sprintf("%s.locales[%s]", field, tag), "invalid language tag; e.g.: en, en-US")
+		}
+	}
+}
+
+// validateFCMAccount Google service account JSON credentials
+func validateFCMAccount(src []byte) error {
+	if len(src) == 0 {
+		return fmt.Errorf("required")
+	}
+	var account struct {
+		Type        string `json:"type"`
+		ProjectId   string `json:"project_id"`
+		ClientEmail string `json:"client_email"`
+		PrivateKey  string `json:"private_key"`
+	}
+	if err := json.Unmarshal(src, &account); err != nil {
+		return fmt.Errorf("invalid service account JSON; %v", err)
+	}
+	if account.Type != "service_account" {
+		return fmt.Errorf("invalid service account JSON; type: service_account expected")
+	}
+	if account.ProjectId == "" || account.ClientEmail == "" {
+		return fmt.Errorf("invalid service account JSON; project_id, client_email required")
+	}
+	if _, err := parsePrivateKey([]byte(account.PrivateKey)); err != nil {
+		return fmt.Errorf("invalid service account JSON; private_key: %v", err)
+	}
+	return nil
+}
+
+func validateAPNService(errs *errors.Violations, field string, src *v1.PushAPNServiceClient) {
+	if proxy := src.GetProxy(); proxy != "" {
+		if err := validateURL(proxy, "http", "https"); err != nil {
+			errs.Add(field+".proxy", "%v", err)
+		}
+	}
+	switch src.GetProto() {
+	case "", "h2", "http/1.1":
+	default:
+		errs.Add(field+".proto", "unknown protocol %q; expect: h2, http/1.1", src.GetProto())
+	}
+	if src.GetTopic() == "" {
+		errs.Add(field+".topic", "required")
+	}
+	token, cert := src.GetToken(), src.GetTls()
+	if token == nil && cert == nil {
+		errs.Add(field, "authentication (token|tls) required")
+	}
+	if token != nil {
+		if len(token.GetKeyId()) != 10 {
+			errs.Add(field+".token.key_id", "10-character Key ID required")
+		}
+		if len(token.GetTeamId()) != 10 {
+			errs.Add(field+".token.team_id", "10-character Team ID required")
+		}
+		// Same format(s) the sender accepts: PEM or base64 (.p8)
+		if _, err := apns.ParseAuthKey(token.GetAuthKey()); err != nil {
+			errs.Add(field+".token.auth_key", "%v", err)
+		}
+	}
+	if cert != nil {
+		if _, err := tls.X509KeyPair(cert.GetCert(), cert.GetPkey()); err != nil {
+			errs.Add(field+".tls", "invalid certificate key pair; %v", err)
+		}
+	}
+}
+
+// parsePrivateKey PEM encoded (PKCS#8) private key
+func parsePrivateKey(src []byte) (any, error) {
+	block, _ := pem.Decode(src)
+	if block == nil {
+		return nil, fmt.Errorf("PEM encoded private key required")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid PKCS#8 private key; %v", err)
+	}
+	return key, nil
+}
+
+func validateURL(rawURL string, schemes ...string) error {
+	if rawURL == "" {
+		return fmt.Errorf("required")
+	}
+	link, err := url.Parse(rawURL)
+	if err != nil || link.Host == "" {
+		return fmt.Errorf("invalid URL %q", rawURL)
+	}
+	if !slices.Contains(schemes, link.Scheme) {
+		return fmt.Errorf("invalid URL %q; scheme %s:// required", rawURL, strings.Join(schemes, "|"))
+	}
+	return nil
+}
+
+func validateContactApp(errs *errors.Violations, field string, src *v1.ContactApp) {
+	if src == nil {
+		return
+	}
+	if auth := src.GetAuth(); auth != nil {
+		validateIdentityProvider(errs, field+".auth", auth)
+	}
+	for i, rule := range src.GetList() {
+		if rule.GetRule() == nil {
+			errs.Add(fmt.Sprintf("%s.list[%d]", field, i), "rule (proto|user_id) required")
+		}
+	}
+}
+
+func validateIdentityProvider(errs *errors.Violations, field string, src *v1.IdentityProvider) {
+	issuers := src.GetIssuers()
+	for i, iss := range issuers {
+		switch {
+		case strings.TrimSpace(iss) == "":
+			errs.Add(fmt.Sprintf("%s.issuers[%d]", field, i), "empty issuer")
+		case slices.Index(issuers, iss) < i:
+			errs.Add(fmt.Sprintf("%s.issuers[%d]", field, i), "duplicate issuer %q", iss)
+		}
+	}
+	for iss := range src.GetProtos() {
+		if !slices.Contains(issuers, iss) {
+			errs.Add(fmt.Sprintf("%s.protos[%s]", field, iss), "issuer not registered")
+		}
+	}
+	jwksURI, jwks := src.GetJwksUri(), src.GetJwks()
+	if jwksURI != "" && len(jwks) > 0 {
+		errs.Add(field+".jwks", "jwks and jwks_uri MUST NOT be used together")
+	}
+	if jwksURI != "" {
+		if err := validateURL(jwksURI, "https"); err != nil {
+			errs.Add(field+".jwks_uri", "%v", err)
+		}
+	}
+	if len(jwks) > 0 {
+		if err := validatePublicJWKS(jwks); err != nil {
+			errs.Add(field+".jwks", "%v", err)
+		}
+	}
+}
+
+// validatePublicJWKS ensures JWK Set contains NO private or symmetric key values
+func validatePublicJWKS(src []byte) error {
+	set, err := jwk.Parse(src)
+	if err != nil {
+		return fmt.Errorf("invalid JWK Set; %v", err)
+	}
+	if set.Len() == 0 {
+		return fmt.Errorf("empty JWK Set")
+	}
+	for i := 0; i < set.Len(); i++ {
+		key, _ := set.Key(i)
+		private, err := jwk.IsPrivateKey(key)
+		if err != nil {
+			return fmt.Errorf("keys[%d]: symmetric key not allowed", i)
+		}
+		if private {
+			return fmt.Errorf("keys[%d]: private key not allowed", i)
+		}
+	}
+	return nil
+}
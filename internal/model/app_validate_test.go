@@ -0,0 +1,154 @@
+package model
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"slices"
+	"testing"
+
+	"github.com/webitel/im-account-service/internal/errors"
+	v1 "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+)
+
+func TestApplicationValidate(test *testing.T) {
+
+	app := ProtoApplication(&v1.Application{
+		Name: " ",
+		Client: &v1.ClientApp{
+			Net: &v1.ClientNet{Cidr: []string{"10.0.0.0/8", "10.0.0.0/33"}},
+			Web: &v1.ClientWeb{Origin: []string{"https://*.example.com", "example.com"}},
+		},
+		Service: &v1.ServiceApp{
+			SendUpdate: &v1.EventSubscription{
+				Endpoint: &v1.EventSubscription_Grpc{
+					Grpc: &v1.GrpcServiceSubscription{Host: "grpc://partner.example.com/updates"},
+				},
+				Events: []string{"sign_in", "sign-out"},
+			},
+			LoginHook: &v1.LoginHook{
+				Endpoint: &v1.LoginHook_Web{
+					Web: &v1.WebhookSubscription{Url: "http://crm.example.com/login"},
+				},
+				Timeout: 60000,
+				Secret:  "short",
+			},
+			RateLimits: &v1.RateLimiter{
+				Zone: map[string]*v1.LimitZone{"ip": {Rate: "ten per sec"}},
+			},
+			PushService: &v1.PUSHServiceClient{
+				Fcm: &v1.PushFCMServiceClient{Account: []byte("{")},
+				Templates: map[string]*v1.NotificationTemplate{
+					"new message": {Title: "{{from}}"},
+					"empty":       {},
+					"call": {
+						Title:   "Incoming call",
+						Locales: map[string]*v1.NotificationTemplate_Variant{"uk_UA!": {Title: "Вхідний дзвінок"}},
+					},
+				},
+			},
+		},
+		Contacts: &v1.ContactApp{
+			Auth: &v1.IdentityProvider{
+				JwksUri: "https://example.com/jwks.json",
+				Jwks:    []byte(`{"keys":[]}`),
+			},
+		},
+	})
+
+	err := app.Validate()
+	invalid, ok := err.(*errors.InvalidError)
+	if !ok {
+		test.Fatalf("app.Validate() = %v, want *errors.InvalidError", err)
+	}
+
+	var fields []string
+	for _, v := range invalid.Violations {
+		fields = append(fields, v.GetField())
+	}
+
+	for _, want := range []string{
+		"name",
+		"client.net.cidr[1]",
+		"client.web.origin[1]",
+		"service.send_update.grpc.host",
+		"service.send_update.events[1]",
+		"service.login_hook.web.url",
+		"service.login_hook.timeout",
+		"service.login_hook.secret",
+		"service.rate_limits.zone[ip].rate",
+		"service.push_service.fcm.account",
+		"service.push_service.templates[new message]",
+		"service.push_service.templates[empty]",
+		"service.push_service.templates[call].locales[uk_UA!]",
+		"contacts.auth.jwks",
+	} {
+		if !slices.Contains(fields, want) {
+			test.Errorf("app.Validate() violations = %v; missing %q", fields, want)
+		}
+	}
+
+	if slices.Contains(fields, "client.net.cidr[0]") || slices.Contains(fields, "client.web.origin[0]") ||
+		slices.Contains(fields, "service.send_update.events[0]") {
+		test.Errorf("app.Validate() violations = %v; unexpected valid field(s)", fields)
+	}
+}
+
+func TestValidateAPNServiceAuthKey(test *testing.T) {
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		test.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name  string
+		key   []byte
+		valid bool
+	}{
+		{"pem", pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), true},
+		{"base64", []byte(base64.StdEncoding.EncodeToString(der)), true},
+		{"invalid", []byte("not a key"), false},
+	} {
+		var errs errors.Violations
+		validateAPNService(&errs, "apn", &v1.PushAPNServiceClient{
+			Topic: "com.example.app",
+			Token: &v1.PushAPNServiceClient_Token{
+				KeyId:   "ABCDE12345",
+				TeamId:  "TEAM123456",
+				AuthKey: tc.key,
+			},
+		})
+		if valid := len(errs) == 0; valid != tc.valid {
+			test.Errorf("validateAPNService(%s) violations = %v; want valid: %t", tc.name, errs, tc.valid)
+		}
+	}
+}
+
+func TestValidateSigningSecret(test *testing.T) {
+
+	for _, tc := range []struct {
+		name   string
+		secret string
+		token  string
+		valid  bool
+	}{
+		{"none", "", "s3cr3t", true},
+		{"valid", "signing-key-0123456789", "s3cr3t", true},
+		{"short", "short", "", false},
+		{"token", "same-as-the-token", "same-as-the-token", false},
+	} {
+		var errs errors.Violations
+		validateSigningSecret(&errs, "send_update", tc.secret, tc.token)
+		if valid := len(errs) == 0; valid != tc.valid {
+			test.Errorf("validateSigningSecret(%s) violations = %v; want valid: %t", tc.name, errs, tc.valid)
+		}
+	}
+}
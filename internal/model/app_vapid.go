@@ -0,0 +1,82 @@
+package model
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/rand"
+	"fmt"
+	"net/url"
+
+	"github.com/webitel/im-account-service/internal/errors"
+	v1 "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// GenerateVAPID returns NEW P-256 Web PUSH VAPID key pair.
+// https://datatracker.ietf.org/doc/html/rfc8292#section-3.2
+func GenerateVAPID() *v1.PushWebServiceClient_VAPID {
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		// crypto/rand never fails
+		panic(fmt.Errorf("vapid: generate key; %v", err))
+	}
+	return &v1.PushWebServiceClient_VAPID{
+		PublicKey:  key.PublicKey().Bytes(),
+		PrivateKey: key.Bytes(),
+	}
+}
+
+// setupVAPID ensures the [dst] Web PUSH service VAPID key pair.
+// Key pair, if not specified, is kept from the [prev] configuration,
+// so browser subscription(s) remain valid ; otherwise generated.
+func setupVAPID(dst, prev *v1.Application) {
+	web := dst.GetService().GetPushService().GetWeb()
+	if web == nil {
+		return // no Web PUSH support
+	}
+	vapid := web.GetVapid()
+	if len(vapid.GetPrivateKey()) > 0 {
+		if len(vapid.GetPublicKey()) == 0 {
+			// derive public key, if valid
+			if key, err := ecdh.P256().NewPrivateKey(vapid.GetPrivateKey()); err == nil {
+				vapid.PublicKey = key.PublicKey().Bytes()
+			}
+		}
+		return
+	}
+	keys := prev.GetService().GetPushService().GetWeb().GetVapid()
+	if len(keys.GetPrivateKey()) > 0 {
+		keys = proto.CloneOf(keys)
+	} else {
+		keys = GenerateVAPID()
+	}
+	if vapid.GetSubject() != "" {
+		keys.Subject = vapid.GetSubject()
+	}
+	web.Vapid = keys
+}
+
+func validateVAPID(errs *errors.Violations, field string, src *v1.PushWebServiceClient_VAPID) {
+	if src == nil {
+		return
+	}
+	if sub := src.GetSubject(); sub != "" {
+		link, err := url.Parse(sub)
+		switch {
+		case err != nil:
+			errs.Add(field+".subject", "invalid URI %q", sub)
+		case link.Scheme == "mailto" && link.Opaque != "":
+		case link.Scheme == "https" && link.Host != "":
+		default:
+			errs.Add(field+".subject", "invalid URI %q; mailto: or https:// required", sub)
+		}
+	}
+	key, err := ecdh.P256().NewPrivateKey(src.GetPrivateKey())
+	if err != nil {
+		errs.Add(field+".private_key", "invalid P-256 private key; 32 bytes required")
+		return
+	}
+	if pub := src.GetPublicKey(); len(pub) > 0 && !bytes.Equal(pub, key.PublicKey().Bytes()) {
+		errs.Add(field+".public_key", "does not match the private_key")
+	}
+}
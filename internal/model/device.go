@@ -7,7 +7,6 @@ import (
 	"strings"
 
 	ua "github.com/mileusna/useragent"
-	v1 "github.com/webitel/im-account-service/proto/gen/im/service/auth/v1"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 )
@@ -18,11 +17,11 @@ type Device struct {
 	// Sub  string               // OPTIONAL. Subscriber ID; Client-side SELF idenitification UNIQUE Device ID; IDFA, GAID, etc
 	// Name string               // Device (Session) name
 
-	Id   string               // OPTIONAL. Subscriber ID; Client-side SELF idenitification UNIQUE Device ID; IDFA, GAID, etc
-	App  ua.UserAgent         // User-Agent: details
-	Addr net.Addr             // Remote (Client) IP address [FROM]
-	From []net.IP             // historical: addresses ever seen [FROM]
-	Push *v1.PUSHSubscription // OPTIONAL. PUSH subscription for async notifications
+	Id   string       // OPTIONAL. Subscriber ID; Client-side SELF idenitification UNIQUE Device ID; IDFA, GAID, etc
+	App  ua.UserAgent // User-Agent: details
+	Addr net.Addr     // Remote (Client) IP address [FROM]
+	From []net.IP     // historical: addresses ever seen [FROM]
+	Push PushTokens   // OPTIONAL. PUSH subscription(s) for async notifications ; per type
 }
 
 // List of Device(s). End-User session conformity
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"time"
+
+	adminpb "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+)
+
+// Default pre-login hook request timeout ; [service.login_hook.timeout]
+const LoginHookTimeout = 5 * time.Second
+
+// LoginHook returns the [app] pre-login hook ; nil if not configured.
+func LoginHook(app *Application) *adminpb.LoginHook {
+	if app == nil {
+		return nil
+	}
+	hook := app.src.GetService().GetLoginHook()
+	if hook.GetEndpoint() == nil {
+		return nil
+	}
+	return hook
+}
+
+// LoginHookDeadline returns the [hook] request timeout ; [LoginHookTimeout] if not set.
+func LoginHookDeadline(hook *adminpb.LoginHook) time.Duration {
+	if ms := hook.GetTimeout(); ms > 0 {
+		return time.Duration(ms) * time.Millisecond
+	}
+	return LoginHookTimeout
+}
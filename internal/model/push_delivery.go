@@ -0,0 +1,157 @@
+package model
+
+import (
+	"math/rand/v2"
+	"time"
+
+	adminpb "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+	v1 "github.com/webitel/im-account-service/proto/gen/im/service/auth/v1"
+)
+
+// PUSH delivery state(s)
+const (
+	PushPending = "pending" // awaiting the (next) attempt
+	PushFailed  = "failed"  // dead-letter ; no more retries
+)
+
+// Reason of the dead-letter record(s) whose message TTL has elapsed
+const PushExpired = "EXPIRED"
+
+// PushDelivery is the PUSH notification outbox record
+// of the single recipient session device.
+// Delivered record(s) are removed.
+type PushDelivery struct {
+	Dc        int64
+	Id        int64
+	SessionId string
+	AppId     string
+	Provider  string     // fcm, apn, web
+	Token     *PushToken // device token ; snapshot
+	Message   *v1.Notification
+
+	State    string // pending, failed
+	Attempts int    // made so far
+	NextAt   time.Time
+
+	// Last attempt result
+	Status int
+	Reason string
+	Error  string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type PushDeliveryList = Dataset[PushDelivery]
+
+// Proto returns the (admin) representation ; token and message omitted.
+func (rec *PushDelivery) Proto() *adminpb.PushDelivery {
+	res := &adminpb.PushDelivery{
+		Id:        rec.Id,
+		SessionId: rec.SessionId,
+		AppId:     rec.AppId,
+		Provider:  rec.Provider,
+		Attempts:  int32(rec.Attempts),
+		CreatedAt: Timestamp.Time(rec.CreatedAt),
+		UpdatedAt: Timestamp.Time(rec.UpdatedAt),
+		Status:    int32(rec.Status),
+		Reason:    rec.Reason,
+		Error:     rec.Error,
+	}
+	switch rec.State {
+	case PushFailed:
+		res.State = adminpb.PushDelivery_FAILED
+	default:
+		res.State = adminpb.PushDelivery_PENDING
+		res.NextAt = Timestamp.Time(rec.NextAt)
+	}
+	return res
+}
+
+// RemainingTTL returns the message time-to-live left at the [now] date,
+// counted since the record was enqueued. Reports [expired] once elapsed.
+// Zero message TTL (platform default) never expires.
+func (rec *PushDelivery) RemainingTTL(now time.Time) (ttl time.Duration, expired bool) {
+	ttl = rec.Message.GetTtl().AsDuration()
+	if ttl <= 0 {
+		return 0, false // platform default
+	}
+	ttl -= now.Sub(rec.CreatedAt)
+	if ttl <= 0 {
+		return 0, true
+	}
+	return ttl, false
+}
+
+// PushRetryClass of the transient delivery failure.
+type PushRetryClass uint8
+
+const (
+	// PushRetryNetwork ; transport failure, no response.
+	PushRetryNetwork PushRetryClass = iota
+	// PushRetryUnavailable ; provider internal error, e.g.: (#5xx).
+	PushRetryUnavailable
+	// PushRetryThrottled ; provider rate limit or quota exceeded, e.g.: (#429).
+	PushRetryThrottled
+)
+
+// PushRetryClassOf returns the retry class of the response HTTP [status] ; zero if none.
+func PushRetryClassOf(status int) PushRetryClass {
+	switch {
+	case status == 429:
+		return PushRetryThrottled
+	case status >= 500:
+		return PushRetryUnavailable
+	}
+	return PushRetryNetwork
+}
+
+// Backoff base delay multiplier per retry class.
+// Throttled provider is given more time to recover.
+var pushRetryFactor = [...]time.Duration{
+	PushRetryNetwork:     1,
+	PushRetryUnavailable: 2,
+	PushRetryThrottled:   4,
+}
+
+// PushOutboxPolicy defines the PUSH delivery outbox worker(s) and retry rules.
+type PushOutboxPolicy struct {
+	// Number of the concurrent outbox worker(s) per node.
+	// Zero: outbox delivery disabled on this node.
+	Workers int
+	// Maximum number of the record(s) claimed per worker at once.
+	Batch int
+	// Interval to poll for the pending record(s), if idle.
+	PollInterval time.Duration
+	// Claimed record(s) lease ; re-claimed after, e.g.: node crash.
+	Lease time.Duration
+	// Maximum number of the delivery attempt(s) ; dead-letter after.
+	MaxAttempts int
+	// Initial and maximum retry delay ; exponential backoff.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+	// Keep dead-letter record(s) for this period ; zero: forever.
+	Retention time.Duration
+}
+
+// RetryDelay returns the delay before the next delivery attempt
+// after the given number of [attempts] made, failed with the [class] error.
+// Provider's [retryAfter] hint, if any, is honoured as the lower bound.
+func (p *PushOutboxPolicy) RetryDelay(class PushRetryClass, attempts int, retryAfter time.Duration) time.Duration {
+	delay := p.Backoff
+	if delay <= 0 {
+		delay = time.Second
+	}
+	if int(class) < len(pushRetryFactor) {
+		delay *= pushRetryFactor[class]
+	}
+	for i := 1; i < attempts && (p.MaxBackoff <= 0 || delay < p.MaxBackoff); i++ {
+		delay *= 2
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	// Full jitter over the upper half ; spread the retry burst(s)
+	delay = delay/2 + rand.N(delay/2+1)
+	return max(delay, retryAfter)
+}
@@ -0,0 +1,86 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	v1 "github.com/webitel/im-account-service/proto/gen/im/service/auth/v1"
+)
+
+func TestPushOutboxPolicyRetryDelay(t *testing.T) {
+
+	policy := PushOutboxPolicy{
+		Backoff:    10 * time.Second,
+		MaxBackoff: time.Hour,
+	}
+
+	for _, test := range []struct {
+		class      PushRetryClass
+		attempts   int
+		retryAfter time.Duration
+		min, max   time.Duration
+	}{
+		{PushRetryNetwork, 1, 0, 5 * time.Second, 10 * time.Second},
+		{PushRetryNetwork, 3, 0, 20 * time.Second, 40 * time.Second},
+		{PushRetryUnavailable, 1, 0, 10 * time.Second, 20 * time.Second},
+		{PushRetryThrottled, 2, 0, 40 * time.Second, 80 * time.Second},
+		{PushRetryNetwork, 100, 0, 30 * time.Minute, time.Hour},
+		{PushRetryThrottled, 1, 2 * time.Hour, 2 * time.Hour, 2 * time.Hour},
+	} {
+		got := policy.RetryDelay(test.class, test.attempts, test.retryAfter)
+		if got < test.min || got > test.max {
+			t.Errorf("RetryDelay( %d, %d, %s ) = %s, want [%s, %s]",
+				test.class, test.attempts, test.retryAfter, got, test.min, test.max,
+			)
+		}
+	}
+}
+
+func TestPushRetryClassOf(t *testing.T) {
+	for status, want := range map[int]PushRetryClass{
+		0:   PushRetryNetwork,
+		429: PushRetryThrottled,
+		500: PushRetryUnavailable,
+		503: PushRetryUnavailable,
+	} {
+		if got := PushRetryClassOf(status); got != want {
+			t.Errorf("PushRetryClassOf( %d ) = %d, want %d", status, got, want)
+		}
+	}
+}
+
+func TestPushDeliveryRemainingTTL(t *testing.T) {
+
+	date := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	for _, test := range []struct {
+		name    string
+		ttl     time.Duration
+		since   time.Duration
+		want    time.Duration
+		expired bool
+	}{
+		{"default", 0, 48 * time.Hour, 0, false},
+		{"fresh", time.Hour, 0, time.Hour, false},
+		{"retry", time.Hour, 20 * time.Minute, 40 * time.Minute, false},
+		{"deadline", time.Hour, time.Hour, 0, true},
+		{"elapsed", time.Minute, time.Hour, 0, true},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			rec := &PushDelivery{
+				Message:   &v1.Notification{},
+				CreatedAt: date,
+			}
+			if test.ttl > 0 {
+				rec.Message.Ttl = durationpb.New(test.ttl)
+			}
+			ttl, expired := rec.RemainingTTL(date.Add(test.since))
+			if ttl != test.want || expired != test.expired {
+				t.Errorf("RemainingTTL() = ( %s, %t ), want ( %s, %t )",
+					ttl, expired, test.want, test.expired,
+				)
+			}
+		})
+	}
+}
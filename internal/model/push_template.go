@@ -0,0 +1,97 @@
+package model
+
+import (
+	"regexp"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	adminpb "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+	v1 "github.com/webitel/im-account-service/proto/gen/im/service/auth/v1"
+)
+
+// PUSH notification template ID ; [A-Za-z0-9_.-]{1,64}
+var pushTemplateRegexp = regexp.MustCompile(`^[A-Za-z0-9_.-]{1,64}$`)
+
+// Template variable placeholder ; {{name}}
+var pushTemplateVarRegexp = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_.-]+)\s*\}\}`)
+
+// BCP47 language tag (simplified) of the template variant, e.g.: en, en-US, uk-UA
+var pushLocaleRegexp = regexp.MustCompile(`^[A-Za-z]{2,3}(-[A-Za-z0-9]{1,8})*$`)
+
+// PushTemplate returns the [app] notification template of the given [id] ; nil if none.
+func PushTemplate(app *Application, id string) *adminpb.NotificationTemplate {
+	if app == nil || id == "" {
+		return nil
+	}
+	return app.src.GetService().GetPushService().GetTemplates()[id]
+}
+
+// PushTemplateVariant returns the [src] template title and body of the [locale] given.
+// Looks up the exact language tag variant, then the language (base) one,
+// falls back to the template default.
+func PushTemplateVariant(src *adminpb.NotificationTemplate, locale string) (title, body string) {
+
+	title, body = src.GetTitle(), src.GetBody()
+
+	locale = strings.ReplaceAll(strings.TrimSpace(locale), "_", "-")
+	if locale == "" || len(src.GetLocales()) == 0 {
+		return // default
+	}
+
+	lookup := []string{locale}
+	if base, _, ok := strings.Cut(locale, "-"); ok {
+		lookup = append(lookup, base)
+	}
+
+	for _, tag := range lookup {
+		for key, variant := range src.GetLocales() {
+			if !strings.EqualFold(key, tag) {
+				continue
+			}
+			if text := variant.GetTitle(); text != "" {
+				title = text
+			}
+			if text := variant.GetBody(); text != "" {
+				body = text
+			}
+			return // found
+		}
+	}
+
+	return // default
+}
+
+// RenderPushTemplate returns the [msg] notification rendered
+// with the [src] template variant of the recipient [locale].
+// Template [msg.Vars] substituted ; [msg.Template] reset.
+func RenderPushTemplate(src *adminpb.NotificationTemplate, locale string, msg *v1.Notification) *v1.Notification {
+
+	res := proto.CloneOf(msg)
+	vars := res.GetVars()
+	render := func(text string) string {
+		return pushTemplateVarRegexp.ReplaceAllStringFunc(text, func(name string) string {
+			return vars[pushTemplateVarRegexp.FindStringSubmatch(name)[1]]
+		})
+	}
+
+	title, body := PushTemplateVariant(src, locale)
+	res.Title = render(title)
+	res.Body = render(body)
+	if src.GetSound() != "" {
+		res.Sound = src.GetSound()
+	}
+	if src.Badge != nil {
+		res.Badge = proto.Int32(src.GetBadge())
+	}
+	if src.GetCategory() != "" {
+		res.Category = src.GetCategory()
+	}
+	if src.GetCollapse() != "" {
+		res.Collapse = render(src.GetCollapse())
+	}
+	res.Template = ""
+	res.Vars = nil
+
+	return res
+}
@@ -0,0 +1,62 @@
+package model
+
+import (
+	"testing"
+
+	adminpb "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+	v1 "github.com/webitel/im-account-service/proto/gen/im/service/auth/v1"
+)
+
+func TestRenderPushTemplate(t *testing.T) {
+
+	badge := int32(1)
+	template := &adminpb.NotificationTemplate{
+		Title:    "New message from {{from}}",
+		Body:     "{{ text }}",
+		Sound:    "message.caf",
+		Badge:    &badge,
+		Category: "MESSAGE",
+		Collapse: "chat-{{chat}}",
+		Locales: map[string]*adminpb.NotificationTemplate_Variant{
+			"uk":    {Title: "Нове повідомлення від {{from}}"},
+			"en-GB": {Title: "New message from {{from}}, mate"},
+		},
+	}
+
+	msg := &v1.Notification{
+		Title:    "ignored",
+		Sound:    "default",
+		Template: "new_message",
+		Vars:     map[string]string{"from": "Alice", "text": "Hi!", "chat": "42"},
+	}
+
+	for _, test := range []struct {
+		locale      string
+		title, body string
+	}{
+		{"", "New message from Alice", "Hi!"},
+		{"uk-UA", "Нове повідомлення від Alice", "Hi!"},
+		{"uk_UA", "Нове повідомлення від Alice", "Hi!"},
+		{"en-gb", "New message from Alice, mate", "Hi!"},
+		{"en-US", "New message from Alice", "Hi!"},
+		{"de", "New message from Alice", "Hi!"},
+	} {
+		res := RenderPushTemplate(template, test.locale, msg)
+		if res.GetTitle() != test.title || res.GetBody() != test.body {
+			t.Errorf("RenderPushTemplate( %q ) = %q, %q; want %q, %q",
+				test.locale, res.GetTitle(), res.GetBody(), test.title, test.body,
+			)
+		}
+		if res.GetSound() != "message.caf" || res.GetBadge() != 1 ||
+			res.GetCategory() != "MESSAGE" || res.GetCollapse() != "chat-42" {
+			t.Errorf("RenderPushTemplate( %q ) = %v; template options not applied", test.locale, res)
+		}
+		if res.GetTemplate() != "" || res.GetVars() != nil {
+			t.Errorf("RenderPushTemplate( %q ) = %v; template not reset", test.locale, res)
+		}
+	}
+
+	if msg.GetTitle() != "ignored" || msg.GetTemplate() == "" {
+		t.Errorf("RenderPushTemplate() modified the source message: %v", msg)
+	}
+}
@@ -1,7 +1,121 @@
 package model
 
 import (
+	"strings"
+	"time"
+
 	v1 "github.com/webitel/im-account-service/proto/gen/im/service/auth/v1"
 )
 
 type PushToken = v1.PUSHSubscription
+
+// PUSH registration type(s) ; See [PushType]
+const (
+	PushAlert = "alert"
+	PushVoIP  = "voip"
+	PushWeb   = "web"
+)
+
+// pushTypes in the primary registration order
+var pushTypes = [...]string{PushAlert, PushWeb, PushVoIP}
+
+// PushType returns the registration type of the [src] token.
+func PushType(src *PushToken) string {
+	switch {
+	case src.GetWeb() != nil:
+		return PushWeb
+	case src.GetType() == v1.PUSHSubscription_VOIP:
+		return PushVoIP
+	}
+	return PushAlert
+}
+
+// PushTokens of the session device ; one registration per [PushType].
+type PushTokens map[string]*PushToken
+
+// Set the [src] token registration of its type.
+// Returns the [src] type.
+func (m *PushTokens) Set(src *PushToken) string {
+	typeOf := PushType(src)
+	if *m == nil {
+		*m = make(PushTokens, 1)
+	}
+	(*m)[typeOf] = src
+	return typeOf
+}
+
+// Primary registration: alert, web or voip ; nil if none.
+func (m PushTokens) Primary() *PushToken {
+	for _, typeOf := range pushTypes {
+		if src := m[typeOf]; src != nil {
+			return src
+		}
+	}
+	return nil
+}
+
+// List of the registration(s) in the primary order.
+func (m PushTokens) List() []*PushToken {
+	var list []*PushToken
+	for _, typeOf := range pushTypes {
+		if src := m[typeOf]; src != nil {
+			list = append(list, src)
+		}
+	}
+	return list
+}
+
+// Route returns the registration to deliver the notification to.
+// Incoming [call] prefers the VoIP token, if registered.
+// Regular notification is never sent to the VoIP token.
+func (m PushTokens) Route(call bool) *PushToken {
+	if src := m[PushVoIP]; call && src != nil {
+		return src
+	}
+	if src := m[PushAlert]; src != nil {
+		return src
+	}
+	return m[PushWeb]
+}
+
+// String returns the registration(s) summary, e.g.: "alert:apn,voip:apn".
+func (m PushTokens) String() string {
+	var text []string
+	for _, src := range m.List() {
+		protom := src.ProtoReflect()
+		field := protom.WhichOneof(
+			protom.Descriptor().Oneofs().ByName("token"),
+		)
+		if field == nil {
+			continue
+		}
+		text = append(text, PushType(src)+":"+string(field.Name()))
+	}
+	return strings.Join(text, ",")
+}
+
+// PushTokenPolicy defines the device PUSH token(s) expiration rules.
+type PushTokenPolicy struct {
+	// Expire token(s) of the session(s) with no activity for this period.
+	// Zero: never expires.
+	IdleTimeout time.Duration
+	// Interval to sweep the idle session(s) token(s).
+	SweepInterval time.Duration
+}
+
+// PushTokenKey returns the [src] token without the optional data,
+// e.g.: the encryption secret or the Web PUSH keys.
+// Identifies the device subscription at the provider.
+func PushTokenKey(src *PushToken) *PushToken {
+	switch token := src.GetToken().(type) {
+	case *v1.PUSHSubscription_Fcm:
+		return &PushToken{Token: &v1.PUSHSubscription_Fcm{Fcm: token.Fcm}}
+	case *v1.PUSHSubscription_Apn:
+		return &PushToken{Token: &v1.PUSHSubscription_Apn{Apn: token.Apn}}
+	case *v1.PUSHSubscription_Web:
+		return &PushToken{Token: &v1.PUSHSubscription_Web{
+			Web: &v1.WebPushSubscription{Endpoint: token.Web.GetEndpoint()},
+		}}
+	}
+	return nil
+}
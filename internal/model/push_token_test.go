@@ -0,0 +1,69 @@
+package model
+
+import (
+	"testing"
+
+	v1 "github.com/webitel/im-account-service/proto/gen/im/service/auth/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestPushTokenKey(t *testing.T) {
+	for _, test := range []struct {
+		src, want *PushToken
+	}{
+		{nil, nil},
+		{
+			&PushToken{Token: &v1.PUSHSubscription_Fcm{Fcm: "fcm"}, Secret: []byte("secret")},
+			&PushToken{Token: &v1.PUSHSubscription_Fcm{Fcm: "fcm"}},
+		},
+		{
+			&PushToken{Token: &v1.PUSHSubscription_Web{Web: &v1.WebPushSubscription{
+				Endpoint: "https://push.example.com/1",
+				Key:      &v1.WebPushSubscription_Key{Auth: []byte("auth")},
+			}}},
+			&PushToken{Token: &v1.PUSHSubscription_Web{Web: &v1.WebPushSubscription{
+				Endpoint: "https://push.example.com/1",
+			}}},
+		},
+	} {
+		if got := PushTokenKey(test.src); !proto.Equal(got, test.want) {
+			t.Errorf("PushTokenKey( %v ) = %v, want %v", test.src, got, test.want)
+		}
+	}
+}
+
+func TestPushTokensRoute(t *testing.T) {
+
+	var (
+		alert = &PushToken{Token: &v1.PUSHSubscription_Apn{Apn: "alert"}}
+		voip  = &PushToken{Token: &v1.PUSHSubscription_Apn{Apn: "voip"}, Type: v1.PUSHSubscription_VOIP}
+		web   = &PushToken{Token: &v1.PUSHSubscription_Web{Web: &v1.WebPushSubscription{Endpoint: "https://push.example.com/1"}}}
+	)
+
+	var tokens PushTokens
+	if got := tokens.Route(true); got != nil {
+		t.Errorf("Route( call ) = %v, want nil", got)
+	}
+
+	tokens.Set(voip)
+	if got := tokens.Route(false); got != nil {
+		t.Errorf("Route( !call ) = %v, want nil ; never VoIP", got)
+	}
+	if got := tokens.Primary(); got != voip {
+		t.Errorf("Primary() = %v, want %v", got, voip)
+	}
+
+	tokens.Set(alert)
+	tokens.Set(web)
+	for call, want := range map[bool]*PushToken{
+		false: alert,
+		true:  voip,
+	} {
+		if got := tokens.Route(call); got != want {
+			t.Errorf("Route( %t ) = %v, want %v", call, got, want)
+		}
+	}
+	if got, want := tokens.String(), "alert:apn,web:web,voip:apn"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
@@ -15,6 +15,8 @@ type Authorization struct {
 	IP net.IP
 	// When was the session created
 	Date time.Time
+	// Last activity date ; zero if none recorded
+	Active time.Time
 	// Session (device) display name.
 	Name string
 	// [X-Webitel-Client] ; Client Application [client_id] ; VIA
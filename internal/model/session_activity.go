@@ -0,0 +1,71 @@
+package model
+
+import (
+	"net"
+	"slices"
+	"time"
+)
+
+// SessionActivity of the authorized session access ; See [SessionActivityPolicy.Touch].
+type SessionActivity struct {
+	// Session ID
+	Id string
+	// Last activity date
+	Date time.Time
+	// Last activity [FROM] IP address ; nil if unknown
+	IP net.IP
+	// IP address(es) history ; most recent first, capped
+	From []net.IP
+}
+
+// SessionActivityPolicy defines the session activity tracking rules.
+type SessionActivityPolicy struct {
+	// Record the session activity at most once per interval,
+	// unless the [FROM] address changed. Zero: disabled.
+	Interval time.Duration
+	// Maximum size of the IP address(es) history.
+	History int
+	// Write the pending (coalesced) record(s) at this interval,
+	// or as soon as [FlushSize] session(s) are pending.
+	FlushInterval time.Duration
+	FlushSize     int
+}
+
+// Touch records the [session] access at [date] from the [device], if due.
+// Returns nil if the latest activity recorded is within the [Interval]
+// from the same address ; otherwise the [session] is updated in place.
+func (policy SessionActivityPolicy) Touch(session *Authorization, device *Device, date time.Time) *SessionActivity {
+
+	if policy.Interval <= 0 || session == nil {
+		return nil // disabled
+	}
+
+	ip := device.IP()
+	from := session.Device.From
+	moved := len(ip) > 0 && (len(from) == 0 || !ip.Equal(from[0]))
+	if !moved && !session.Active.IsZero() && date.Sub(session.Active) < policy.Interval {
+		return nil // throttled
+	}
+
+	peer := Device{From: slices.Clone(from)}
+	if moved {
+		peer.Addr = device.Addr
+		peer.FromAddr()
+	}
+	if size := max(policy.History, 1); len(peer.From) > size {
+		peer.From = peer.From[:size]
+	}
+
+	session.Active = date
+	session.Device.From = peer.From
+	if len(ip) > 0 {
+		session.Device.Addr = device.Addr
+	}
+
+	return &SessionActivity{
+		Id:   session.Id,
+		Date: date,
+		IP:   ip,
+		From: peer.From,
+	}
+}
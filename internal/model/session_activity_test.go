@@ -0,0 +1,51 @@
+package model
+
+import (
+	"net"
+	"testing"
+	"time"
+)
+
+func TestSessionActivityTouch(test *testing.T) {
+
+	policy := SessionActivityPolicy{Interval: time.Minute, History: 2}
+	from := func(ip string) *Device {
+		return &Device{Addr: &net.IPAddr{IP: net.ParseIP(ip)}}
+	}
+
+	date := time.Now()
+	session := &Authorization{Id: "s1"}
+
+	touch := policy.Touch(session, from("10.0.0.1"), date)
+	if touch == nil || !touch.IP.Equal(net.ParseIP("10.0.0.1")) || len(touch.From) != 1 {
+		test.Fatalf("Touch(first) = %+v", touch)
+	}
+
+	// Throttled ; same address within the interval
+	if touch = policy.Touch(session, from("10.0.0.1"), date.Add(time.Second)); touch != nil {
+		test.Errorf("Touch(throttled) = %+v, want nil", touch)
+	}
+
+	// Address changed ; recorded at once
+	touch = policy.Touch(session, from("10.0.0.2"), date.Add(2*time.Second))
+	if touch == nil || len(touch.From) != 2 || !touch.From[0].Equal(net.ParseIP("10.0.0.2")) {
+		test.Fatalf("Touch(moved) = %+v", touch)
+	}
+
+	// History capped ; most recent first
+	touch = policy.Touch(session, from("10.0.0.3"), date.Add(3*time.Second))
+	if touch == nil || len(touch.From) != 2 || !touch.From[1].Equal(net.ParseIP("10.0.0.2")) {
+		test.Fatalf("Touch(capped) = %+v", touch)
+	}
+
+	// Interval elapsed ; same address
+	touch = policy.Touch(session, from("10.0.0.3"), date.Add(2*time.Minute))
+	if touch == nil || !session.Active.Equal(date.Add(2*time.Minute)) {
+		test.Errorf("Touch(elapsed) = %+v", touch)
+	}
+
+	// Disabled
+	if touch = (SessionActivityPolicy{}).Touch(session, from("10.0.0.4"), date.Add(time.Hour)); touch != nil {
+		test.Errorf("Touch(disabled) = %+v, want nil", touch)
+	}
+}
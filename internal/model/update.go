@@ -0,0 +1,165 @@
+package model
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	clientpb "github.com/webitel/im-account-service/proto/gen/im/client/v1"
+	adminpb "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+)
+
+// Update.Data.(type)s ; App [service.send_update.events] filter
+const (
+	UpdateSignIn             = "sign_in"
+	UpdateSignOut            = "sign_out"
+	UpdateSessionRevoked     = "session_revoked"
+	UpdateDeviceRegistered   = "device_registered"
+	UpdateDeviceUnregistered = "device_unregistered"
+	UpdateContactUpserted    = "contact_upserted"
+)
+
+// updateTypes known ; See [UpdateType]
+var updateTypes = []string{
+	UpdateSignIn,
+	UpdateSignOut,
+	UpdateSessionRevoked,
+	UpdateDeviceRegistered,
+	UpdateDeviceUnregistered,
+	UpdateContactUpserted,
+}
+
+// UpdateType returns the [src] Update.Data.(type) name ; empty if none.
+func UpdateType(src *clientpb.Update) string {
+	protom := src.ProtoReflect()
+	field := protom.WhichOneof(
+		protom.Descriptor().Oneofs().ByName("data"),
+	)
+	if field == nil {
+		return ""
+	}
+	return string(field.Name())
+}
+
+// UpdateSubscription returns the [app] Update(s) subscription
+// of the [typeOf] event ; nil if not subscribed.
+// Empty [events] filter subscribes to all the Update type(s).
+func UpdateSubscription(app *Application, typeOf string) *adminpb.EventSubscription {
+	if app == nil {
+		return nil
+	}
+	sub := app.src.GetService().GetSendUpdate()
+	if sub.GetEndpoint() == nil {
+		return nil
+	}
+	if events := sub.GetEvents(); len(events) > 0 && !slices.Contains(events, typeOf) {
+		return nil
+	}
+	return sub
+}
+
+// UpdateServiceTarget parses the [service.send_update.grpc] subscription [host] ; [http(s)://]host[:port].
+// Default: https, port 443 ; http: plaintext, port 80.
+func UpdateServiceTarget(host string) (target string, secure bool, err error) {
+
+	host = strings.TrimSpace(host)
+	if !strings.Contains(host, "://") {
+		host = "https://" + host
+	}
+
+	link, err := url.Parse(host)
+	if err != nil {
+		return "", false, err
+	}
+
+	port := "443"
+	switch link.Scheme {
+	case "https":
+		secure = true
+	case "http":
+		port = "80"
+	default:
+		return "", false, fmt.Errorf("scheme %q not supported", link.Scheme)
+	}
+
+	if link.Hostname() == "" || (link.Path != "" && link.Path != "/") {
+		return "", false, fmt.Errorf("[http(s)://]host[:port] required")
+	}
+
+	if link.Port() != "" {
+		port = link.Port()
+	}
+
+	return net.JoinHostPort(link.Hostname(), port), secure, nil
+}
+
+// UpdateSession returns the Update representation of the [src] session.
+func UpdateSession(src *Authorization) *clientpb.Session {
+	if src == nil {
+		return nil
+	}
+	res := &clientpb.Session{
+		Id:        src.Id,
+		Name:      src.Name,
+		DeviceId:  src.Device.Id,
+		UserAgent: src.Device.App.String,
+	}
+	if ip := src.Device.IP(); len(ip) > 0 {
+		res.Ip = ip.String()
+	} else if len(src.IP) > 0 {
+		res.Ip = src.IP.String()
+	}
+	return res
+}
+
+// UpdateContact returns the Update representation of the [src] contact identity.
+func UpdateContact(src *ContactId) *clientpb.Contact {
+	if src.IsZero() {
+		return nil
+	}
+	return &clientpb.Contact{
+		Id:  src.Id,
+		Iss: src.Iss,
+		Sub: src.Sub,
+	}
+}
+
+// App Update delivery state(s)
+const (
+	UpdatePending = "pending" // awaiting the (next) attempt
+	UpdateFailed  = "failed"  // dead-letter ; no more retries
+)
+
+// UpdateDelivery is the App [Update] outbox record
+// of the [service.send_update] subscription endpoint.
+// Delivered record(s) are removed.
+type UpdateDelivery struct {
+	Dc        int64
+	Id        int64 // [Update.Id]
+	AppId     string
+	ContactId string // OPTIONAL ; related contact
+	Type      string // Update.Data.(type)
+	Update    *clientpb.Update
+
+	State    string // pending, failed ; See [UpdatePending]
+	Attempts int    // made so far
+	NextAt   time.Time
+
+	// Last attempt result
+	Status int
+	Error  string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// UpdateOutboxPolicy defines the App Update(s) delivery outbox worker(s) and retry rules.
+type UpdateOutboxPolicy struct {
+	// Same worker(s) and retry rules as of the PUSH delivery outbox
+	PushOutboxPolicy
+	// Endpoint request timeout
+	Timeout time.Duration
+}
@@ -0,0 +1,306 @@
+// Package apns implements the [A]pple [P]ush [N]otification service sender.
+//
+// https://developer.apple.com/documentation/usernotifications/sending-notification-requests-to-apns
+package apns
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/webitel/im-account-service/internal/push"
+	adminpb "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+)
+
+// APNs endpoint(s)
+const (
+	Production = "https://api.push.apple.com"
+	Sandbox    = "https://api.sandbox.push.apple.com"
+)
+
+// Push type(s) ; [apns-push-type] header
+const (
+	PushAlert      = "alert"
+	PushBackground = "background"
+	PushVoIP       = "voip"
+)
+
+// Client sends notifications via APNs provider API.
+type Client struct {
+	endpoint string
+	topic    string
+	client   *http.Client
+	tokens   *tokenSource // OPTIONAL ; token-based auth
+	now      func() time.Time
+}
+
+var _ push.Sender = (*Client)(nil)
+
+// Option of the APNs Client.
+type Option func(c *options)
+
+type options struct {
+	transport *http.Transport
+	timeout   time.Duration
+	now       func() time.Time
+}
+
+// WithTransport to clone the APNs connection transport from.
+// Client certificate and protocol are set on the clone.
+func WithTransport(base *http.Transport) Option {
+	return func(c *options) {
+		if base != nil {
+			c.transport = base
+		}
+	}
+}
+
+// WithTimeout of the single notification request.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *options) {
+		c.timeout = timeout
+	}
+}
+
+// WithClock to be used instead of [time.Now].
+func WithClock(now func() time.Time) Option {
+	return func(c *options) {
+		if now != nil {
+			c.now = now
+		}
+	}
+}
+
+// New APNs Client of the App [config].
+// Token-based authentication is preferred, if both given.
+func New(config *adminpb.PushAPNServiceClient, opts ...Option) (*Client, error) {
+
+	conf := options{
+		transport: http.DefaultTransport.(*http.Transport),
+		timeout:   30 * time.Second,
+		now:       time.Now,
+	}
+	for _, setup := range opts {
+		setup(&conf)
+	}
+
+	if config.GetTopic() == "" {
+		return nil, fmt.Errorf("apns: topic required")
+	}
+
+	endpoint := strings.TrimRight(config.GetProxy(), "/")
+	if endpoint == "" {
+		endpoint = Production
+	}
+	if _, err := url.Parse(endpoint); err != nil {
+		return nil, fmt.Errorf("apns: proxy; %v", err)
+	}
+
+	transport := conf.transport.Clone()
+	switch config.GetProto() {
+	case "", "h2":
+		transport.ForceAttemptHTTP2 = true
+	case "http/1.1":
+		transport.ForceAttemptHTTP2 = false
+		// Non-nil empty map disables HTTP/2
+		transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+		if transport.TLSClientConfig != nil {
+			transport.TLSClientConfig.NextProtos = []string{"http/1.1"}
+		}
+	default:
+		return nil, fmt.Errorf("apns: proto %q not supported", config.GetProto())
+	}
+
+	c := &Client{
+		endpoint: endpoint,
+		topic:    config.GetTopic(),
+		now:      conf.now,
+	}
+
+	if token := config.GetToken(); token != nil {
+		source, err := newTokenSource(token, conf.now)
+		if err != nil {
+			return nil, err
+		}
+		c.tokens = source
+	} else if cert := config.GetTls(); cert != nil {
+		pair, err := tls.X509KeyPair(cert.GetCert(), cert.GetPkey())
+		if err != nil {
+			return nil, fmt.Errorf("apns: tls; %v", err)
+		}
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{}
+		}
+		transport.TLSClientConfig.Certificates = []tls.Certificate{pair}
+	} else {
+		return nil, fmt.Errorf("apns: authentication (token|tls) required")
+	}
+
+	c.client = &http.Client{
+		Transport: transport,
+		Timeout:   conf.timeout,
+	}
+
+	return c, nil
+}
+
+// CloseIdleConnections closes the idle connection(s) of the Client transport.
+// Call when the Client is replaced ; the transport is NOT shared.
+func (c *Client) CloseIdleConnections() {
+	c.client.CloseIdleConnections()
+}
+
+// Send [msg] to the APNs device [token].
+func (c *Client) Send(ctx context.Context, token string, msg *push.Message) *push.Result {
+
+	body, err := json.Marshal(payload(msg))
+	if err != nil {
+		return &push.Result{Outcome: push.Failed, Err: err}
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, c.endpoint+"/3/device/"+url.PathEscape(token),
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return &push.Result{Outcome: push.Failed, Err: err}
+	}
+
+	header := req.Header
+	header.Set("Content-Type", "application/json")
+	header.Set("apns-topic", c.topic)
+	header.Set("apns-push-type", PushAlert)
+	header.Set("apns-priority", "5")
+	switch {
+	case msg.VoIP:
+		// PushKit ; the app MUST report the incoming call immediately
+		header.Set("apns-topic", c.topic+".voip")
+		header.Set("apns-push-type", PushVoIP)
+		header.Set("apns-priority", "10")
+	case msg.Silent():
+		// MUST be priority 5 ; otherwise rejected
+		header.Set("apns-push-type", PushBackground)
+	case msg.Priority == push.PriorityHigh, msg.Call:
+		header.Set("apns-priority", "10")
+	}
+	if msg.TTL > 0 {
+		header.Set("apns-expiration", strconv.FormatInt(c.now().Add(msg.TTL).Unix(), 10))
+	}
+	if msg.Collapse != "" {
+		header.Set("apns-collapse-id", msg.Collapse)
+	}
+
+	var auth string
+	if c.tokens != nil {
+		auth, err = c.tokens.Token()
+		if err != nil {
+			return &push.Result{Outcome: push.Failed, Err: err}
+		}
+		header.Set("Authorization", "bearer "+auth)
+	}
+
+	rsp, err := c.client.Do(req)
+	if err != nil {
+		return push.Failure(err)
+	}
+	defer rsp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(rsp.Body, 1<<16))
+	if err != nil {
+		return push.Failure(err)
+	}
+
+	if rsp.StatusCode == http.StatusOK {
+		return &push.Result{
+			Outcome:  push.Delivered,
+			Status:   rsp.StatusCode,
+			Id:       rsp.Header.Get("apns-id"),
+			Response: data,
+		}
+	}
+
+	res := failure(rsp.StatusCode, data)
+	res.Response = data
+	res.RetryAfter = push.RetryAfter(rsp.Header, c.now())
+	if res.Reason == "ExpiredProviderToken" && c.tokens != nil {
+		// Sign NEW provider token on retry
+		c.tokens.Reset(auth)
+		res.Outcome = push.Retry
+	}
+	return res
+}
+
+// payload encodes APNs notification JSON payload.
+// Custom [msg.Data] key(s) are set next to the [aps] dictionary.
+// https://developer.apple.com/documentation/usernotifications/generating-a-remote-notification
+func payload(msg *push.Message) map[string]any {
+
+	res := make(map[string]any, len(msg.Data)+1)
+	for key, value := range msg.Data {
+		res[key] = value
+	}
+
+	aps := map[string]any{}
+	if msg.Silent() {
+		aps["content-available"] = 1
+	} else {
+		aps["alert"] = map[string]string{
+			"title": msg.Title,
+			"body":  msg.Body,
+		}
+	}
+	if msg.Sound != "" {
+		aps["sound"] = msg.Sound
+	}
+	if msg.Badge != nil {
+		aps["badge"] = *msg.Badge
+	}
+	if msg.Collapse != "" {
+		aps["thread-id"] = msg.Collapse
+	}
+	if msg.Category != "" {
+		aps["category"] = msg.Category
+	}
+
+	res["aps"] = aps
+	return res
+}
+
+// failure maps APNs error response to the delivery Result.
+// https://developer.apple.com/documentation/usernotifications/handling-notification-responses-from-apns
+func failure(status int, data []byte) *push.Result {
+
+	var re struct {
+		Reason    string `json:"reason"`
+		Timestamp int64  `json:"timestamp"`
+	}
+	_ = json.Unmarshal(data, &re)
+
+	res := &push.Result{
+		Outcome: push.Failed,
+		Status:  status,
+		Reason:  re.Reason,
+		Err:     fmt.Errorf("apns: (#%d) %s", status, re.Reason),
+	}
+
+	switch {
+	case status == http.StatusGone:
+		// Unregistered, ExpiredToken
+		res.Outcome = push.Unregistered
+	case re.Reason == "BadDeviceToken" || re.Reason == "DeviceTokenNotForTopic":
+		res.Outcome = push.Unregistered
+	case status == http.StatusTooManyRequests || status >= 500:
+		// TooManyRequests, InternalServerError, ServiceUnavailable, Shutdown
+		res.Outcome = push.Retry
+	}
+
+	return res
+}
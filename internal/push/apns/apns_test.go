@@ -0,0 +1,131 @@
+package apns_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v3/jwa"
+	"github.com/lestrrat-go/jwx/v3/jws"
+	"github.com/webitel/im-account-service/internal/push"
+	"github.com/webitel/im-account-service/internal/push/apns"
+	adminpb "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+)
+
+func TestClientSend(t *testing.T) {
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, _ := x509.MarshalPKCS8PrivateKey(key)
+
+	var (
+		mx     sync.Mutex
+		tokens = map[string]bool{}
+		expire = true
+	)
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := strings.TrimPrefix(r.Header.Get("Authorization"), "bearer ")
+		msg, err := jws.Verify([]byte(auth), jws.WithKey(jwa.ES256(), &key.PublicKey))
+		if err != nil {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"reason":"InvalidProviderToken"}`))
+			return
+		}
+		var claims struct {
+			Iss string `json:"iss"`
+		}
+		_ = json.Unmarshal(msg, &claims)
+		topic := "com.example.app"
+		if r.Header.Get("apns-push-type") == apns.PushVoIP {
+			topic += ".voip"
+		}
+		if claims.Iss != "TEAM012345" || r.Header.Get("apns-topic") != topic {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"reason":"InvalidProviderToken"}`))
+			return
+		}
+		mx.Lock()
+		tokens[auth] = true
+		rotate := expire
+		expire = false
+		mx.Unlock()
+		if rotate {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"reason":"ExpiredProviderToken"}`))
+			return
+		}
+		switch strings.TrimPrefix(r.URL.Path, "/3/device/") {
+		case "gone":
+			w.WriteHeader(http.StatusGone)
+			_, _ = w.Write([]byte(`{"reason":"Unregistered","timestamp":1700000000000}`))
+		case "bad":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"reason":"BadDeviceToken"}`))
+		case "busy":
+			w.Header().Set("Retry-After", "7")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"reason":"ServiceUnavailable"}`))
+		case "large":
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			_, _ = w.Write([]byte(`{"reason":"PayloadTooLarge"}`))
+		default:
+			w.Header().Set("apns-id", "apns-id")
+		}
+	}))
+	defer srv.Close()
+
+	client, err := apns.New(&adminpb.PushAPNServiceClient{
+		Proxy: srv.URL,
+		Proto: "http/1.1",
+		Topic: "com.example.app",
+		Token: &adminpb.PushAPNServiceClient_Token{
+			KeyId:   "KEY0123456",
+			TeamId:  "TEAM012345",
+			AuthKey: pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}),
+		},
+	}, apns.WithTransport(srv.Client().Transport.(*http.Transport)))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg := &push.Message{Title: "Hello", Body: "World", TTL: time.Minute}
+	for _, test := range []struct {
+		token   string
+		voip    bool
+		outcome push.Outcome
+	}{
+		{"device", false, push.Retry}, // ExpiredProviderToken
+		{"device", false, push.Delivered},
+		{"device", true, push.Delivered}, // [.voip] topic
+		{"gone", false, push.Unregistered},
+		{"bad", false, push.Unregistered},
+		{"busy", false, push.Retry},
+		{"large", false, push.Failed},
+	} {
+		msg.VoIP = test.voip
+		res := client.Send(context.Background(), test.token, msg)
+		if res.Outcome != test.outcome {
+			t.Errorf("Send( %s ) = %s ; %s, want %s", test.token, res.Outcome, res.Error(), test.outcome)
+		}
+		if test.token == "busy" && res.RetryAfter != 7*time.Second {
+			t.Errorf("Send( %s ).RetryAfter = %s, want 7s", test.token, res.RetryAfter)
+		}
+	}
+
+	if n := len(tokens); n != 2 {
+		t.Errorf("provider token signed %d times, want twice", n)
+	}
+}
@@ -0,0 +1,112 @@
+package apns
+
+import (
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v3/jwa"
+	"github.com/lestrrat-go/jwx/v3/jws"
+	adminpb "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+)
+
+// Provider token refresh interval.
+// APNs rejects tokens older than one hour
+// and refreshed more often than once per 20 minutes.
+const tokenRefresh = 40 * time.Minute
+
+// tokenSource signs ES256 provider authentication tokens.
+// Token is cached and reused until refresh interval.
+type tokenSource struct {
+	keyId  string
+	teamId string
+	key    *ecdsa.PrivateKey
+	now    func() time.Time
+
+	mx     sync.Mutex
+	token  string
+	issued time.Time
+}
+
+func newTokenSource(config *adminpb.PushAPNServiceClient_Token, now func() time.Time) (*tokenSource, error) {
+
+	if config.GetKeyId() == "" || config.GetTeamId() == "" {
+		return nil, fmt.Errorf("apns: token; key_id and team_id required")
+	}
+
+	key, err := ParseAuthKey(config.GetAuthKey())
+	if err != nil {
+		return nil, err
+	}
+
+	return &tokenSource{
+		keyId:  config.GetKeyId(),
+		teamId: config.GetTeamId(),
+		key:    key,
+		now:    now,
+	}, nil
+}
+
+// ParseAuthKey decodes the (.p8) ECDSA P-256 signing key:
+// PEM or base64 encoded PKCS#8 data.
+func ParseAuthKey(src []byte) (*ecdsa.PrivateKey, error) {
+	der := src
+	if block, _ := pem.Decode(src); block != nil {
+		der = block.Bytes
+	} else if data, err := base64.StdEncoding.DecodeString(string(src)); err == nil {
+		der = data
+	}
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("apns: token; auth_key %v", err)
+	}
+	ecKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("apns: token; auth_key %T not supported", key)
+	}
+	return ecKey, nil
+}
+
+// Token returns cached provider token, or signs a NEW one.
+func (c *tokenSource) Token() (string, error) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	now := c.now()
+	if c.token != "" && now.Sub(c.issued) < tokenRefresh {
+		return c.token, nil
+	}
+
+	claims, _ := json.Marshal(map[string]any{
+		"iss": c.teamId,
+		"iat": now.Unix(),
+	})
+
+	header := jws.NewHeaders()
+	_ = header.Set(jws.KeyIDKey, c.keyId)
+
+	token, err := jws.Sign(claims, jws.WithKey(
+		jwa.ES256(), c.key, jws.WithProtectedHeaders(header),
+	))
+	if err != nil {
+		return "", fmt.Errorf("apns: token; %v", err)
+	}
+
+	c.token = string(token)
+	c.issued = now
+	return c.token, nil
+}
+
+// Reset the cached provider token, e.g.: rejected as expired.
+func (c *tokenSource) Reset(token string) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	if c.token == token {
+		c.token = ""
+	}
+}
@@ -0,0 +1,336 @@
+// Package dispatch fans out the notification message
+// to the end-User session(s) device(s) via the App PUSH service(s).
+package dispatch
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/webitel/im-account-service/internal/model"
+	"github.com/webitel/im-account-service/internal/push"
+	"github.com/webitel/im-account-service/internal/push/apns"
+	"github.com/webitel/im-account-service/internal/push/fcm"
+	"github.com/webitel/im-account-service/internal/push/webpush"
+	authpb "github.com/webitel/im-account-service/proto/gen/im/service/auth/v1"
+)
+
+// PUSH service provider(s)
+const (
+	FCM = "fcm"
+	APN = "apn"
+	Web = "web"
+)
+
+// Default concurrent requests limit per provider.
+const DefaultLimit = 64
+
+// Default period to keep the App sender(s) NOT used, e.g.: App deleted.
+const DefaultIdleTimeout = time.Hour
+
+// Target device of the notification.
+type Target struct {
+	// Session with the PUSH subscription
+	Session *model.Authorization
+	// Session client App ; PUSH service(s) configuration
+	App *model.Application
+	// Session device registration to deliver to ; See [model.PushTokens.Route]
+	Token *model.PushToken
+}
+
+// Result of the notification delivery to the [Target] device.
+type Result struct {
+	Target
+	// Provider of the PUSH service
+	Provider string
+	*push.Result
+}
+
+// Options of the Dispatcher.
+type Options struct {
+	Logger *slog.Logger
+	// Concurrent requests limit per provider.
+	// Missing or zero: [DefaultLimit].
+	Limits map[string]int
+	// Period to keep the App sender(s) NOT used.
+	// Zero: [DefaultIdleTimeout].
+	IdleTimeout time.Duration
+	// NewSender of the App PUSH service [provider] configuration.
+	// Default: FCM, APNs or Web Push client.
+	NewSender func(app *model.Application, provider string) (push.Sender, error)
+}
+
+// Dispatcher sends notification(s) concurrently,
+// with the concurrent requests limit per provider.
+// Sender(s) are built per App configuration version and reused.
+type Dispatcher struct {
+	logger    *slog.Logger
+	limits    map[string]chan struct{}
+	idle      time.Duration
+	newSender func(app *model.Application, provider string) (push.Sender, error)
+
+	mx      sync.Mutex
+	senders map[string]*appSenders // [app.id]
+	swept   time.Time              // last [evict] date
+}
+
+// appSenders of the App configuration version.
+type appSenders struct {
+	ver     int32
+	used    time.Time
+	senders map[string]push.Sender // [provider]
+	errs    map[string]error       // [provider]
+}
+
+// close releases the idle connection(s) of the sender(s) transport, if any.
+// Sender(s) MAY still complete the request(s) in progress.
+func (cache *appSenders) close() {
+	for _, sender := range cache.senders {
+		if conn, ok := sender.(interface{ CloseIdleConnections() }); ok {
+			conn.CloseIdleConnections()
+		}
+	}
+}
+
+// New Dispatcher of the given [opts].
+func New(opts Options) *Dispatcher {
+	if opts.Logger == nil {
+		opts.Logger = slog.Default()
+	}
+	limits := make(map[string]chan struct{}, 3)
+	for _, provider := range []string{FCM, APN, Web} {
+		size := opts.Limits[provider]
+		if size <= 0 {
+			size = DefaultLimit
+		}
+		limits[provider] = make(chan struct{}, size)
+	}
+	if opts.IdleTimeout <= 0 {
+		opts.IdleTimeout = DefaultIdleTimeout
+	}
+	if opts.NewSender == nil {
+		opts.NewSender = newSender
+	}
+	return &Dispatcher{
+		logger:    opts.Logger,
+		limits:    limits,
+		idle:      opts.IdleTimeout,
+		newSender: opts.NewSender,
+		senders:   make(map[string]*appSenders),
+	}
+}
+
+// Close releases ALL the App sender(s).
+func (c *Dispatcher) Close() {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	for id, cache := range c.senders {
+		cache.close()
+		delete(c.senders, id)
+	}
+}
+
+// Send [msg] to all the [targets] device(s) concurrently.
+// Result(s) are returned in the [targets] order.
+func (c *Dispatcher) Send(ctx context.Context, targets []Target, msg *push.Message) []Result {
+
+	var (
+		wg  sync.WaitGroup
+		res = make([]Result, len(targets))
+	)
+
+	for i, target := range targets {
+		res[i].Target = target
+		provider, token := Token(target.Token)
+		res[i].Provider = provider
+		if provider == "" {
+			res[i].Result = &push.Result{
+				Outcome: push.Unregistered, Reason: "NO_PUSH_TOKEN",
+				Err: fmt.Errorf("push: session( %s ); no device token", target.Session.Id),
+			}
+			continue
+		}
+		sender, err := c.sender(target.App, provider)
+		if err != nil {
+			res[i].Result = &push.Result{
+				Outcome: push.Failed, Reason: "NO_PUSH_SERVICE", Err: err,
+			}
+			continue
+		}
+		msg := msg
+		if voip := (model.PushType(target.Token) == model.PushVoIP); voip != msg.VoIP {
+			clone := *msg
+			clone.VoIP = voip
+			msg = &clone
+		}
+		wg.Add(1)
+		go func(dst *Result) {
+			defer wg.Done()
+			limit := c.limits[provider]
+			select {
+			case limit <- struct{}{}:
+				defer func() { <-limit }()
+			case <-ctx.Done():
+				dst.Result = push.Failure(ctx.Err())
+				return
+			}
+			dst.Result = sender.Send(ctx, token, msg)
+		}(&res[i])
+	}
+
+	wg.Wait()
+
+	for _, re := range res {
+		if re.Outcome == push.Delivered {
+			continue
+		}
+		c.logger.Warn(
+			"[ PUSH ] "+re.Error(),
+			"provider", re.Provider,
+			"session", re.Session.Id,
+			"app", re.Session.AppId,
+		)
+	}
+
+	return res
+}
+
+// Token returns the PUSH subscription provider and the [push.Sender] device token.
+// Empty [provider] if none.
+func Token(src *model.PushToken) (provider, token string) {
+	switch sub := src.GetToken().(type) {
+	case *authpb.PUSHSubscription_Fcm:
+		if sub.Fcm != "" {
+			return FCM, sub.Fcm
+		}
+	case *authpb.PUSHSubscription_Apn:
+		if sub.Apn != "" {
+			return APN, sub.Apn
+		}
+	case *authpb.PUSHSubscription_Web:
+		if sub.Web.GetEndpoint() != "" {
+			return Web, webpush.Token(sub.Web)
+		}
+	}
+	return "", ""
+}
+
+// ParseToken returns the raw device [token] registration of the PUSH [provider] ; See [Token].
+// The [voip] flag marks the [APN] token as the VoIP (PushKit) registration.
+func ParseToken(provider, token string, voip bool) (*model.PushToken, error) {
+	if token == "" {
+		return nil, fmt.Errorf("push: %s; token required", provider)
+	}
+	switch provider {
+	case FCM:
+		return &authpb.PUSHSubscription{
+			Token: &authpb.PUSHSubscription_Fcm{Fcm: token},
+		}, nil
+	case APN:
+		src := &authpb.PUSHSubscription{
+			Token: &authpb.PUSHSubscription_Apn{Apn: token},
+		}
+		if voip {
+			src.Type = authpb.PUSHSubscription_VOIP
+		}
+		return src, nil
+	case Web:
+		endpoint, p256dh, auth, err := webpush.ParseSubscription(token)
+		if err != nil {
+			return nil, err
+		}
+		return &authpb.PUSHSubscription{
+			Token: &authpb.PUSHSubscription_Web{
+				Web: &authpb.WebPushSubscription{
+					Endpoint: endpoint.String(),
+					Key: &authpb.WebPushSubscription_Key{
+						Auth: auth, P256Dh: p256dh,
+					},
+				},
+			},
+		}, nil
+	}
+	return nil, fmt.Errorf("push: provider %q; not supported", provider)
+}
+
+// sender returns the [app] PUSH [provider] sender.
+// Sender is (re)built on the App configuration version change.
+func (c *Dispatcher) sender(app *model.Application, provider string) (push.Sender, error) {
+
+	if app == nil {
+		return nil, fmt.Errorf("push: %s; no client app", provider)
+	}
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	now := time.Now()
+	c.evict(now)
+
+	cache := c.senders[app.ClientId()]
+	if cache == nil || cache.ver != app.Version() {
+		if cache != nil {
+			cache.close() // outdated
+		}
+		cache = &appSenders{
+			ver:     app.Version(),
+			senders: make(map[string]push.Sender),
+			errs:    make(map[string]error),
+		}
+		c.senders[app.ClientId()] = cache
+	}
+	cache.used = now
+
+	if sender, ok := cache.senders[provider]; ok {
+		return sender, nil
+	}
+	if err, ok := cache.errs[provider]; ok {
+		return nil, err
+	}
+
+	sender, err := c.newSender(app, provider)
+	if err != nil {
+		cache.errs[provider] = err
+		return nil, err
+	}
+	cache.senders[provider] = sender
+	return sender, nil
+}
+
+// evict releases the App(s) sender(s) NOT used for the idle period,
+// e.g.: App deleted or moved to the other node(s). Sweeps once per idle period.
+// Caller MUST hold the lock.
+func (c *Dispatcher) evict(now time.Time) {
+	if now.Sub(c.swept) < c.idle {
+		return
+	}
+	c.swept = now
+	for id, cache := range c.senders {
+		if now.Sub(cache.used) >= c.idle {
+			cache.close()
+			delete(c.senders, id)
+		}
+	}
+}
+
+// newSender of the [app] PUSH service [provider] configuration.
+func newSender(app *model.Application, provider string) (push.Sender, error) {
+	config := app.Proto().GetService().GetPushService()
+	switch provider {
+	case FCM:
+		if conf := config.GetFcm(); conf != nil {
+			return fcm.New(conf)
+		}
+	case APN:
+		if conf := config.GetApn(); conf != nil {
+			return apns.New(conf)
+		}
+	case Web:
+		if conf := config.GetWeb(); conf != nil {
+			return webpush.New(conf)
+		}
+	}
+	return nil, fmt.Errorf("push: app( %s ); no [service.push.%s] client configuration", app.ClientId(), provider)
+}
@@ -0,0 +1,150 @@
+package dispatch_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/webitel/im-account-service/internal/model"
+	"github.com/webitel/im-account-service/internal/push"
+	"github.com/webitel/im-account-service/internal/push/dispatch"
+	adminpb "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+	authpb "github.com/webitel/im-account-service/proto/gen/im/service/auth/v1"
+)
+
+func TestDispatcherSend(t *testing.T) {
+
+	app := model.NewApplication(&adminpb.InputApp{
+		Dc:   1,
+		Name: "app",
+	})
+
+	target := func(id string, token *authpb.PUSHSubscription, app *model.Application) dispatch.Target {
+		session := &model.Authorization{
+			Dc: 1, Id: id, AppId: app.ClientId(),
+		}
+		if token != nil {
+			session.Device.Push.Set(token)
+		}
+		return dispatch.Target{
+			Session: session, App: app,
+			Token: session.Device.Push.Route(false),
+		}
+	}
+
+	targets := []dispatch.Target{
+		target("none", nil, app),
+		target("fcm", &authpb.PUSHSubscription{
+			Token: &authpb.PUSHSubscription_Fcm{Fcm: "token"},
+		}, app),
+		target("apn", &authpb.PUSHSubscription{
+			Token: &authpb.PUSHSubscription_Apn{Apn: "token"},
+		}, app),
+	}
+	targets[2].App = nil
+
+	res := dispatch.New(dispatch.Options{}).Send(
+		context.Background(), targets, &push.Message{Title: "Hello"},
+	)
+
+	for i, want := range []struct {
+		provider string
+		outcome  push.Outcome
+	}{
+		{"", push.Unregistered},
+		{dispatch.FCM, push.Failed}, // no service configuration
+		{dispatch.APN, push.Failed}, // no client app
+	} {
+		if res[i].Provider != want.provider || res[i].Outcome != want.outcome {
+			t.Errorf("Send( %s ) = %s %s, want %s %s",
+				targets[i].Session.Id, res[i].Provider, res[i].Outcome, want.provider, want.outcome,
+			)
+		}
+	}
+}
+
+// stubSender of the App configuration version.
+type stubSender struct {
+	ver    int32
+	closed int // idle connection(s) released
+}
+
+func (c *stubSender) Send(context.Context, string, *push.Message) *push.Result {
+	return &push.Result{Outcome: push.Delivered}
+}
+
+func (c *stubSender) CloseIdleConnections() {
+	c.closed++
+}
+
+func TestDispatcherSenders(t *testing.T) {
+
+	var (
+		built     []*stubSender
+		newSender = func(app *model.Application, provider string) (push.Sender, error) {
+			sender := &stubSender{ver: app.Version()}
+			built = append(built, sender)
+			return sender, nil
+		}
+		target = func(id string, ver int32) []dispatch.Target {
+			app := model.ProtoApplication(&adminpb.Application{Dc: 1, Id: id, Ver: ver})
+			session := &model.Authorization{
+				Dc: 1, Id: "session", AppId: app.ClientId(),
+			}
+			session.Device.Push.Set(&authpb.PUSHSubscription{
+				Token: &authpb.PUSHSubscription_Fcm{Fcm: "token"},
+			})
+			return []dispatch.Target{{
+				App: app, Session: session,
+				Token: session.Device.Push.Route(false),
+			}}
+		}
+		ctx = context.Background()
+		msg = &push.Message{Title: "Hello"}
+	)
+
+	t.Run("version", func(t *testing.T) {
+		built = nil
+		sender := dispatch.New(dispatch.Options{NewSender: newSender})
+
+		sender.Send(ctx, target("app", 1), msg)
+		sender.Send(ctx, target("app", 1), msg)
+		if len(built) != 1 {
+			t.Fatalf("Send( ver: 1 ) built %d sender(s), want 1 ; reused", len(built))
+		}
+
+		sender.Send(ctx, target("app", 2), msg)
+		if len(built) != 2 || built[1].ver != 2 {
+			t.Fatalf("Send( ver: 2 ) built %d sender(s), want 2 ; rebuilt", len(built))
+		}
+		if built[0].closed != 1 || built[1].closed != 0 {
+			t.Errorf("Send( ver: 2 ) closed = [%d %d], want [1 0] ; replaced", built[0].closed, built[1].closed)
+		}
+
+		sender.Close()
+		if built[1].closed != 1 {
+			t.Errorf("Close() closed = %d, want 1", built[1].closed)
+		}
+	})
+
+	t.Run("idle", func(t *testing.T) {
+		built = nil
+		sender := dispatch.New(dispatch.Options{
+			NewSender: newSender, IdleTimeout: time.Millisecond,
+		})
+
+		sender.Send(ctx, target("deleted", 1), msg)
+		time.Sleep(5 * time.Millisecond)
+		sender.Send(ctx, target("app", 1), msg)
+
+		if len(built) != 2 || built[0].closed != 1 {
+			t.Fatalf("Send() evicted %d sender(s), want 1 ; idle", built[0].closed)
+		}
+
+		time.Sleep(5 * time.Millisecond)
+		sender.Send(ctx, target("deleted", 1), msg)
+		if len(built) != 3 {
+			t.Errorf("Send() built %d sender(s), want 3 ; evicted", len(built))
+		}
+	})
+}
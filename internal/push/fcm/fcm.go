@@ -0,0 +1,303 @@
+// Package fcm implements the [F]irebase [C]loud [M]essaging HTTP v1 API sender.
+//
+// https://firebase.google.com/docs/reference/fcm/rest/v1/projects.messages/send
+package fcm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/webitel/im-account-service/internal/push"
+	adminpb "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+)
+
+// Default FCM HTTP v1 API endpoint ; {project_id} substituted.
+const DefaultEndpoint = "https://fcm.googleapis.com/v1/projects/{project_id}/messages:send"
+
+// Client sends messages via FCM HTTP v1 API.
+type Client struct {
+	endpoint string
+	client   *http.Client
+	tokens   *tokenSource
+	now      func() time.Time
+}
+
+var _ push.Sender = (*Client)(nil)
+
+// Option of the FCM Client.
+type Option func(c *Client)
+
+// WithHTTPClient to send API requests with.
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Client) {
+		if client != nil {
+			c.client = client
+		}
+	}
+}
+
+// WithClock to be used instead of [time.Now].
+func WithClock(now func() time.Time) Option {
+	return func(c *Client) {
+		if now != nil {
+			c.now = now
+		}
+	}
+}
+
+// New FCM Client of the App [config].
+// The [config.proxy] URL, if any, is used instead of the [DefaultEndpoint].
+func New(config *adminpb.PushFCMServiceClient, opts ...Option) (*Client, error) {
+
+	account, key, err := ParseServiceAccount(config.GetAccount())
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := config.GetProxy()
+	if endpoint == "" {
+		endpoint = DefaultEndpoint
+	}
+
+	c := &Client{
+		endpoint: strings.ReplaceAll(endpoint, "{project_id}", account.ProjectId),
+		client:   http.DefaultClient,
+		now:      time.Now,
+	}
+
+	for _, setup := range opts {
+		setup(c)
+	}
+
+	c.tokens = &tokenSource{
+		account: account,
+		key:     key,
+		client:  c.client,
+		now:     c.now,
+	}
+
+	return c, nil
+}
+
+// Send [msg] to the FCM registration [token].
+func (c *Client) Send(ctx context.Context, token string, msg *push.Message) *push.Result {
+
+	auth, err := c.tokens.Token(ctx)
+	if err != nil {
+		if re, ok := err.(*tokenError); ok && re.Status < 500 && re.Status != http.StatusTooManyRequests {
+			// Invalid service account credentials
+			return &push.Result{Outcome: push.Failed, Status: re.Status, Reason: re.Code, Err: re}
+		}
+		return push.Failure(err)
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"message": message(token, msg, c.now()),
+	})
+	if err != nil {
+		return &push.Result{Outcome: push.Failed, Err: err}
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, c.endpoint, bytes.NewReader(body),
+	)
+	if err != nil {
+		return &push.Result{Outcome: push.Failed, Err: err}
+	}
+	req.Header.Set("Authorization", "Bearer "+auth)
+	req.Header.Set("Content-Type", "application/json")
+
+	rsp, err := c.client.Do(req)
+	if err != nil {
+		return push.Failure(err)
+	}
+	defer rsp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(rsp.Body, 1<<20))
+	if err != nil {
+		return push.Failure(err)
+	}
+
+	if rsp.StatusCode == http.StatusOK {
+		var res struct {
+			Name string `json:"name"`
+		}
+		_ = json.Unmarshal(data, &res)
+		return &push.Result{Outcome: push.Delivered, Status: rsp.StatusCode, Id: res.Name, Response: data}
+	}
+
+	res := failure(rsp.StatusCode, data)
+	res.Response = data
+	res.RetryAfter = push.RetryAfter(rsp.Header, c.now())
+	if rsp.StatusCode == http.StatusUnauthorized && res.Reason != "THIRD_PARTY_AUTH_ERROR" {
+		// Access token expired or revoked ; mint NEW one on retry
+		c.tokens.Reset(auth)
+		res.Outcome = push.Retry
+	}
+	return res
+}
+
+// message encodes FCM v1 Message resource.
+// https://firebase.google.com/docs/reference/fcm/rest/v1/projects.messages#Message
+func message(token string, msg *push.Message, now time.Time) map[string]any {
+
+	var (
+		res     = map[string]any{"token": token}
+		android = map[string]any{}
+		apns    = map[string]any{}
+		headers = map[string]string{}
+		aps     = map[string]any{}
+	)
+
+	data := msg.Data
+	switch {
+	case msg.Silent():
+		aps["content-available"] = 1
+	case msg.Call:
+		// Android: data-only ; the app wakes up to handle the call itself.
+		// iOS: visible alert ; FCM token is no PushKit registration.
+		data = make(map[string]string, len(msg.Data)+2)
+		data["title"] = msg.Title
+		data["body"] = msg.Body
+		for key, value := range msg.Data {
+			data[key] = value
+		}
+		aps["alert"] = map[string]string{
+			"title": msg.Title,
+			"body":  msg.Body,
+		}
+	default:
+		res["notification"] = map[string]string{
+			"title": msg.Title,
+			"body":  msg.Body,
+		}
+	}
+
+	if len(data) > 0 {
+		res["data"] = data
+	}
+
+	switch {
+	case msg.Priority == push.PriorityHigh, msg.Call:
+		android["priority"] = "HIGH"
+		headers["apns-priority"] = "10"
+	default:
+		android["priority"] = "NORMAL"
+		headers["apns-priority"] = "5"
+	}
+	if msg.Silent() {
+		// APNs rejects high priority background push
+		headers["apns-priority"] = "5"
+	}
+
+	if msg.TTL > 0 {
+		android["ttl"] = strconv.FormatInt(int64(msg.TTL/time.Second), 10) + "s"
+		headers["apns-expiration"] = strconv.FormatInt(now.Add(msg.TTL).Unix(), 10)
+	}
+
+	if msg.Collapse != "" {
+		android["collapse_key"] = msg.Collapse
+		headers["apns-collapse-id"] = msg.Collapse
+	}
+
+	notification := map[string]string{}
+	if msg.Sound != "" {
+		notification["sound"] = msg.Sound
+		aps["sound"] = msg.Sound
+	}
+	if msg.Badge != nil {
+		aps["badge"] = *msg.Badge
+	}
+	if msg.Category != "" {
+		aps["category"] = msg.Category
+		if !msg.Silent() && !msg.Call {
+			notification["click_action"] = msg.Category
+		}
+	}
+	if len(notification) > 0 {
+		android["notification"] = notification
+	}
+
+	res["android"] = android
+	apns["headers"] = headers
+	if len(aps) > 0 {
+		apns["payload"] = map[string]any{"aps": aps}
+	}
+	res["apns"] = apns
+
+	return res
+}
+
+// FCM error response.
+// https://firebase.google.com/docs/reference/fcm/rest/v1/ErrorCode
+type apiError struct {
+	Error struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Status  string `json:"status"`
+		Details []struct {
+			Type            string `json:"@type"`
+			ErrorCode       string `json:"errorCode"`
+			FieldViolations []struct {
+				Field string `json:"field"`
+			} `json:"fieldViolations"`
+		} `json:"details"`
+	} `json:"error"`
+}
+
+// failure maps FCM error response to the delivery Result.
+func failure(status int, data []byte) *push.Result {
+
+	var re apiError
+	_ = json.Unmarshal(data, &re)
+
+	res := &push.Result{
+		Outcome: push.Failed,
+		Status:  status,
+		Reason:  re.Error.Status,
+	}
+
+	tokenInvalid := false
+	for _, detail := range re.Error.Details {
+		if detail.ErrorCode != "" {
+			res.Reason = detail.ErrorCode
+		}
+		for _, field := range detail.FieldViolations {
+			if field.Field == "message.token" {
+				tokenInvalid = true
+			}
+		}
+	}
+
+	if text := re.Error.Message; text != "" {
+		res.Err = fmt.Errorf("fcm: %s", text)
+	} else {
+		res.Err = fmt.Errorf("fcm: %s", http.StatusText(status))
+	}
+
+	switch res.Reason {
+	case "UNREGISTERED", "SENDER_ID_MISMATCH":
+		res.Outcome = push.Unregistered
+	case "INVALID_ARGUMENT":
+		if tokenInvalid {
+			res.Outcome = push.Unregistered
+		}
+	case "QUOTA_EXCEEDED", "UNAVAILABLE", "INTERNAL":
+		res.Outcome = push.Retry
+	case "THIRD_PARTY_AUTH_ERROR", "PERMISSION_DENIED":
+		res.Outcome = push.Failed
+	default:
+		if status >= 500 || status == http.StatusTooManyRequests {
+			res.Outcome = push.Retry
+		}
+	}
+
+	return res
+}
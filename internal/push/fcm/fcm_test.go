@@ -0,0 +1,117 @@
+package fcm_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v3/jwa"
+	"github.com/lestrrat-go/jwx/v3/jws"
+	"github.com/webitel/im-account-service/internal/push"
+	"github.com/webitel/im-account-service/internal/push/fcm"
+	adminpb "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+)
+
+func TestClientSend(t *testing.T) {
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, _ := x509.MarshalPKCS8PrivateKey(key)
+
+	var minted atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		_, err := jws.Verify(
+			[]byte(r.PostFormValue("assertion")),
+			jws.WithKey(jwa.RS256(), &key.PublicKey),
+		)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+		minted.Add(1)
+		_, _ = w.Write([]byte(`{"access_token":"access","expires_in":3600,"token_type":"Bearer"}`))
+	})
+	mux.HandleFunc("POST /v1/projects/project/messages:send", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer access" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var req struct {
+			Message struct {
+				Token string `json:"token"`
+			} `json:"message"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		switch req.Message.Token {
+		case "gone":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":404,"status":"NOT_FOUND","message":"Requested entity was not found.",
+				"details":[{"@type":"type.googleapis.com/google.firebase.fcm.v1.FcmError","errorCode":"UNREGISTERED"}]}}`))
+		case "busy":
+			w.Header().Set("Retry-After", "7")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"error":{"code":503,"status":"UNAVAILABLE"}}`))
+		case "bad":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":{"code":400,"status":"INVALID_ARGUMENT",
+				"details":[{"@type":"type.googleapis.com/google.rpc.BadRequest","fieldViolations":[{"field":"message.data"}]}]}}`))
+		default:
+			_, _ = w.Write([]byte(`{"name":"projects/project/messages/1"}`))
+		}
+	})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	account, _ := json.Marshal(map[string]string{
+		"type":         "service_account",
+		"project_id":   "project",
+		"private_key":  string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		"client_email": "push@project.iam.gserviceaccount.com",
+		"token_uri":    srv.URL + "/token",
+	})
+
+	client, err := fcm.New(&adminpb.PushFCMServiceClient{
+		Proxy:   srv.URL + "/v1/projects/{project_id}/messages:send",
+		Account: account,
+	}, fcm.WithHTTPClient(srv.Client()))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg := &push.Message{Title: "Hello", Body: "World", TTL: time.Minute}
+	for _, test := range []struct {
+		token   string
+		outcome push.Outcome
+	}{
+		{"device", push.Delivered},
+		{"gone", push.Unregistered},
+		{"busy", push.Retry},
+		{"bad", push.Failed},
+	} {
+		res := client.Send(context.Background(), test.token, msg)
+		if res.Outcome != test.outcome {
+			t.Errorf("Send( %s ) = %s ; %s, want %s", test.token, res.Outcome, res.Error(), test.outcome)
+		}
+		if test.token == "busy" && res.RetryAfter != 7*time.Second {
+			t.Errorf("Send( %s ).RetryAfter = %s, want 7s", test.token, res.RetryAfter)
+		}
+	}
+
+	if n := minted.Load(); n != 1 {
+		t.Errorf("access token minted %d times, want once", n)
+	}
+}
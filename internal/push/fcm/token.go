@@ -0,0 +1,207 @@
+package fcm
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v3/jwa"
+	"github.com/lestrrat-go/jwx/v3/jws"
+)
+
+const (
+	// OAuth2 scope to send FCM messages
+	scopeMessaging = "https://www.googleapis.com/auth/firebase.messaging"
+	// Default OAuth2 token endpoint of the service account
+	defaultTokenURL = "https://oauth2.googleapis.com/token"
+	// Signed JWT assertion lifetime ; maximum allowed
+	assertionTTL = time.Hour
+	// Refresh access token this early before it expires
+	expiryDelta = time.Minute
+)
+
+// ServiceAccount JSON credentials (key file).
+// https://firebase.google.com/docs/cloud-messaging/auth-server
+type ServiceAccount struct {
+	Type         string `json:"type"`
+	ProjectId    string `json:"project_id"`
+	PrivateKeyId string `json:"private_key_id"`
+	PrivateKey   string `json:"private_key"`
+	ClientEmail  string `json:"client_email"`
+	TokenURI     string `json:"token_uri"`
+}
+
+// ParseServiceAccount decodes the service account JSON [data]
+// and its RSA private key.
+func ParseServiceAccount(data []byte) (*ServiceAccount, *rsa.PrivateKey, error) {
+
+	var account ServiceAccount
+	err := json.Unmarshal(data, &account)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fcm: service account; %v", err)
+	}
+
+	if account.Type != "service_account" {
+		return nil, nil, fmt.Errorf("fcm: service account; type %q not supported", account.Type)
+	}
+	if account.ProjectId == "" || account.ClientEmail == "" {
+		return nil, nil, fmt.Errorf("fcm: service account; project_id and client_email required")
+	}
+
+	block, _ := pem.Decode([]byte(account.PrivateKey))
+	if block == nil {
+		return nil, nil, fmt.Errorf("fcm: service account; private_key PEM required")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fcm: service account; private_key %v", err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, nil, fmt.Errorf("fcm: service account; private_key %T not supported", key)
+	}
+
+	if account.TokenURI == "" {
+		account.TokenURI = defaultTokenURL
+	}
+
+	return &account, rsaKey, nil
+}
+
+// tokenSource mints OAuth2 access tokens with the service account
+// signed JWT assertion exchange. Token is cached until it expires.
+type tokenSource struct {
+	account *ServiceAccount
+	key     *rsa.PrivateKey
+	client  *http.Client
+	now     func() time.Time
+
+	mx      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+// tokenError is the OAuth2 token endpoint error response.
+type tokenError struct {
+	Status      int
+	Code        string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (err *tokenError) Error() string {
+	return fmt.Sprintf("fcm: oauth2 token (#%d) %s ; %s", err.Status, err.Code, err.Description)
+}
+
+// Token returns cached access token, or mints a NEW one.
+func (c *tokenSource) Token(ctx context.Context) (string, error) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	now := c.now()
+	if c.token != "" && now.Add(expiryDelta).Before(c.expires) {
+		return c.token, nil
+	}
+
+	token, expiresIn, err := c.exchange(ctx, now)
+	if err != nil {
+		return "", err
+	}
+
+	c.token = token
+	c.expires = now.Add(expiresIn)
+	return token, nil
+}
+
+// Reset the cached access token, e.g.: rejected as expired.
+func (c *tokenSource) Reset(token string) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	if c.token == token {
+		c.token = ""
+	}
+}
+
+// exchange signed JWT assertion for the access token.
+func (c *tokenSource) exchange(ctx context.Context, now time.Time) (string, time.Duration, error) {
+
+	claims, _ := json.Marshal(map[string]any{
+		"iss":   c.account.ClientEmail,
+		"scope": scopeMessaging,
+		"aud":   c.account.TokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(assertionTTL).Unix(),
+	})
+
+	header := jws.NewHeaders()
+	_ = header.Set("typ", "JWT")
+	if c.account.PrivateKeyId != "" {
+		_ = header.Set(jws.KeyIDKey, c.account.PrivateKeyId)
+	}
+
+	assertion, err := jws.Sign(claims, jws.WithKey(
+		jwa.RS256(), c.key, jws.WithProtectedHeaders(header),
+	))
+	if err != nil {
+		return "", 0, fmt.Errorf("fcm: oauth2 assertion; %v", err)
+	}
+
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {string(assertion)},
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, c.account.TokenURI,
+		strings.NewReader(form.Encode()),
+	)
+	if err != nil {
+		return "", 0, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	rsp, err := c.client.Do(req)
+	if err != nil {
+		return "", 0, err
+	}
+	defer rsp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(rsp.Body, 1<<20))
+	if err != nil {
+		return "", 0, err
+	}
+
+	if rsp.StatusCode != http.StatusOK {
+		re := &tokenError{Status: rsp.StatusCode}
+		_ = json.Unmarshal(body, re)
+		return "", 0, re
+	}
+
+	var res struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+		TokenType   string `json:"token_type"`
+	}
+	err = json.Unmarshal(body, &res)
+	if err != nil {
+		return "", 0, fmt.Errorf("fcm: oauth2 token; %v", err)
+	}
+	if res.AccessToken == "" {
+		return "", 0, fmt.Errorf("fcm: oauth2 token; access_token missing")
+	}
+
+	expiresIn := time.Duration(res.ExpiresIn) * time.Second
+	if expiresIn <= 0 {
+		expiresIn = assertionTTL
+	}
+
+	return res.AccessToken, expiresIn, nil
+}
@@ -0,0 +1,204 @@
+// Package push delivers notification messages
+// to the end-user device(s) via the platform PUSH service(s).
+package push
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Priority of the notification delivery.
+type Priority uint8
+
+const (
+	// PriorityNormal ; battery-friendly, MAY be delayed.
+	PriorityNormal Priority = iota
+	// PriorityHigh ; deliver immediately, MAY wake up the device.
+	PriorityHigh
+)
+
+// Message to be delivered to the device.
+type Message struct {
+	// Visible notification.
+	// Empty: silent, data-only message.
+	Title string
+	Body  string
+
+	// Custom key-value payload for the client app.
+	Data map[string]string
+
+	// Delivery options
+	Priority Priority
+	TTL      time.Duration // zero: platform default
+	Collapse string        // collapse (thread) key ; OPTIONAL
+
+	// Platform specific options
+	Sound    string // OPTIONAL
+	Badge    *int   // OPTIONAL ; iOS
+	Category string // OPTIONAL ; notification actions set identifier
+
+	// Incoming call ; deliver immediately, data-only where supported.
+	Call bool
+	// Device token is the VoIP (PushKit) registration ; iOS
+	VoIP bool
+}
+
+// Silent reports whether [msg] has no visible notification.
+func (msg *Message) Silent() bool {
+	return msg.Title == "" && msg.Body == ""
+}
+
+// Outcome of the message delivery attempt.
+type Outcome uint8
+
+const (
+	// Delivered ; accepted by the platform PUSH service.
+	Delivered Outcome = iota
+	// Retry ; transient failure, the same message MAY be sent later.
+	Retry
+	// Failed ; permanent failure, e.g.: bad message or credentials. Do NOT retry.
+	Failed
+	// Unregistered ; device token is no longer valid. Do NOT retry and forget the token.
+	Unregistered
+)
+
+func (outcome Outcome) String() string {
+	switch outcome {
+	case Delivered:
+		return "delivered"
+	case Retry:
+		return "retry"
+	case Failed:
+		return "failed"
+	case Unregistered:
+		return "unregistered"
+	}
+	return "outcome(" + strconv.Itoa(int(outcome)) + ")"
+}
+
+// Result of the message delivery attempt.
+type Result struct {
+	Outcome
+	// Platform message ID ; Delivered
+	Id string
+	// HTTP status code of the platform response, if any
+	Status int
+	// Platform error reason code, e.g.: UNREGISTERED, BadDeviceToken
+	Reason string
+	// Platform [Retry-After] hint, if any ; Retry
+	RetryAfter time.Duration
+	// Error details ; NOT Delivered
+	Err error
+	// Raw platform response body, if any ; diagnostics
+	Response []byte
+}
+
+// Error returns the delivery failure text ; empty if Delivered.
+func (res *Result) Error() string {
+	if res.Outcome == Delivered {
+		return ""
+	}
+	text := fmt.Sprintf("push: %s", res.Outcome)
+	if res.Status > 0 {
+		text += fmt.Sprintf(" ; (#%d)", res.Status)
+	}
+	if res.Reason != "" {
+		text += " " + res.Reason
+	}
+	if res.Err != nil {
+		text += " ; " + res.Err.Error()
+	}
+	return text
+}
+
+// Cause of the delivery failure ; diagnostics.
+type Cause uint8
+
+const (
+	// CauseNone ; Delivered
+	CauseNone Cause = iota
+	// CauseConfig ; App PUSH service NOT configured or invalid
+	CauseConfig
+	// CauseCredentials ; PUSH service credentials rejected
+	CauseCredentials
+	// CauseToken ; device token is missing, invalid or expired
+	CauseToken
+	// CauseProvider ; PUSH service throttled or unavailable
+	CauseProvider
+	// CauseNetwork ; transport-level failure, no response
+	CauseNetwork
+	// CauseRequest ; message rejected by the PUSH service
+	CauseRequest
+)
+
+func (cause Cause) String() string {
+	switch cause {
+	case CauseNone:
+		return "none"
+	case CauseConfig:
+		return "config"
+	case CauseCredentials:
+		return "credentials"
+	case CauseToken:
+		return "token"
+	case CauseProvider:
+		return "provider"
+	case CauseNetwork:
+		return "network"
+	case CauseRequest:
+		return "request"
+	}
+	return "cause(" + strconv.Itoa(int(cause)) + ")"
+}
+
+// Cause classifies the delivery failure ; CauseNone if Delivered.
+func (res *Result) Cause() Cause {
+	switch {
+	case res.Outcome == Delivered:
+		return CauseNone
+	case res.Outcome == Unregistered:
+		return CauseToken
+	case res.Reason == "NO_PUSH_SERVICE":
+		return CauseConfig
+	case res.Status == http.StatusUnauthorized, res.Status == http.StatusForbidden:
+		return CauseCredentials
+	case res.Status == http.StatusTooManyRequests, res.Status >= 500:
+		return CauseProvider
+	case res.Status == 0 && res.Outcome == Retry:
+		return CauseNetwork
+	case res.Status == 0:
+		// Local failure, e.g.: credentials signature, message encoding
+		return CauseConfig
+	}
+	return CauseRequest
+}
+
+// Sender of the [Message] to the device [token]
+// via the platform PUSH service.
+type Sender interface {
+	Send(ctx context.Context, token string, msg *Message) *Result
+}
+
+// Failure returns the [err] transport-level failure Result ; Retry.
+func Failure(err error) *Result {
+	return &Result{Outcome: Retry, Err: err}
+}
+
+// RetryAfter parses the HTTP [Retry-After] response header value:
+// delay-seconds or HTTP-date. Returns zero if none or invalid.
+func RetryAfter(header http.Header, now time.Time) time.Duration {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(value); err == nil && secs > 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+	return 0
+}
@@ -0,0 +1,32 @@
+package push
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestResultCause(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		res  Result
+		want Cause
+	}{
+		{"delivered", Result{Outcome: Delivered, Status: 200}, CauseNone},
+		{"unregistered", Result{Outcome: Unregistered, Status: 410, Reason: "Unregistered"}, CauseToken},
+		{"no_token", Result{Outcome: Unregistered, Reason: "NO_PUSH_TOKEN"}, CauseToken},
+		{"no_service", Result{Outcome: Failed, Reason: "NO_PUSH_SERVICE"}, CauseConfig},
+		{"bad_key", Result{Outcome: Failed, Err: errors.New("apns: token; invalid key")}, CauseConfig},
+		{"unauthorized", Result{Outcome: Failed, Status: 401, Reason: "UNAUTHENTICATED"}, CauseCredentials},
+		{"forbidden", Result{Outcome: Failed, Status: 403, Reason: "InvalidProviderToken"}, CauseCredentials},
+		{"throttled", Result{Outcome: Retry, Status: 429}, CauseProvider},
+		{"unavailable", Result{Outcome: Retry, Status: 503}, CauseProvider},
+		{"network", *Failure(errors.New("dial tcp: i/o timeout")), CauseNetwork},
+		{"bad_request", Result{Outcome: Failed, Status: 400, Reason: "PayloadTooLarge"}, CauseRequest},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.res.Cause(); got != tc.want {
+				t.Errorf("Cause() = %s; want %s", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package webpush
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+)
+
+const (
+	// Record size of the single encrypted record.
+	// Push services MUST support message body of 4096 octets.
+	recordSize = 4096
+	// aes128gcm content header size: salt(16) + rs(4) + idlen(1) + keyid(65)
+	headerSize = 16 + 4 + 1 + 65
+	// MaxPayload size of the plaintext message: 4096 octets body,
+	// less the header, padding delimiter(1) and AEAD tag(16) octets
+	MaxPayload = 4096 - headerSize - 1 - 16
+)
+
+// encrypt [plaintext] for the user agent subscription keys.
+// Returns the "aes128gcm" encoded message body.
+// https://datatracker.ietf.org/doc/html/rfc8291#section-3.4
+func encrypt(plaintext []byte, p256dh, auth []byte) ([]byte, error) {
+
+	if len(plaintext) > MaxPayload {
+		return nil, fmt.Errorf("webpush: payload too large; %d > %d octets", len(plaintext), MaxPayload)
+	}
+
+	uaPublic, err := ecdh.P256().NewPublicKey(p256dh)
+	if err != nil {
+		return nil, fmt.Errorf("webpush: subscription p256dh key; %v", err)
+	}
+	if len(auth) != 16 {
+		return nil, fmt.Errorf("webpush: subscription auth secret; 16 octets required")
+	}
+
+	// Ephemeral application server key pair
+	asPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	asPublic := asPrivate.PublicKey().Bytes()
+
+	secret, err := asPrivate.ECDH(uaPublic)
+	if err != nil {
+		return nil, err
+	}
+
+	// IKM = HKDF(auth_secret, ecdh_secret, "WebPush: info" || 0x00 || ua_public || as_public, 32)
+	info := make([]byte, 0, 14+65+65)
+	info = append(info, "WebPush: info\x00"...)
+	info = append(info, p256dh...)
+	info = append(info, asPublic...)
+	ikm, err := hkdf.Key(sha256.New, secret, auth, string(info), 32)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, 16)
+	_, _ = rand.Read(salt)
+
+	// https://datatracker.ietf.org/doc/html/rfc8188#section-2.2
+	prk, err := hkdf.Extract(sha256.New, ikm, salt)
+	if err != nil {
+		return nil, err
+	}
+	cek, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: aes128gcm\x00", 16)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: nonce\x00", 12)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	body := make([]byte, headerSize, headerSize+len(plaintext)+1+aead.Overhead())
+	copy(body, salt)
+	binary.BigEndian.PutUint32(body[16:], recordSize)
+	body[20] = byte(len(asPublic))
+	copy(body[21:], asPublic)
+
+	// Single (last) record ; padding delimiter 0x02
+	record := make([]byte, 0, len(plaintext)+1)
+	record = append(record, plaintext...)
+	record = append(record, 0x02)
+
+	return aead.Seal(body, nonce, record, nil), nil
+}
@@ -0,0 +1,94 @@
+package webpush
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v3/jwa"
+	"github.com/lestrrat-go/jwx/v3/jws"
+	adminpb "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+)
+
+const (
+	// VAPID token lifetime ; MUST NOT exceed 24 hours
+	vapidTTL = 12 * time.Hour
+	// Sign NEW token this early before it expires
+	vapidRefresh = time.Hour
+)
+
+// vapidSigner signs VAPID JWT per push service origin (audience).
+// Token is cached and reused until it expires.
+// https://datatracker.ietf.org/doc/html/rfc8292#section-2
+type vapidSigner struct {
+	subject   string
+	key       *ecdsa.PrivateKey
+	publicKey string // base64url ; [k] parameter
+	now       func() time.Time
+
+	mx     sync.Mutex
+	tokens map[string]vapidToken // [aud]
+}
+
+type vapidToken struct {
+	token   string
+	expires time.Time
+}
+
+func newVAPIDSigner(config *adminpb.PushWebServiceClient_VAPID, now func() time.Time) (*vapidSigner, error) {
+
+	key, err := ecdsa.ParseRawPrivateKey(elliptic.P256(), config.GetPrivateKey())
+	if err != nil {
+		return nil, fmt.Errorf("webpush: vapid private_key; %v", err)
+	}
+	publicKey, err := key.PublicKey.Bytes()
+	if err != nil {
+		return nil, fmt.Errorf("webpush: vapid public_key; %v", err)
+	}
+
+	return &vapidSigner{
+		subject:   config.GetSubject(),
+		key:       key,
+		publicKey: base64.RawURLEncoding.EncodeToString(publicKey),
+		now:       now,
+		tokens:    make(map[string]vapidToken),
+	}, nil
+}
+
+// Authorization header value for the push service [aud]ience origin.
+func (c *vapidSigner) Authorization(aud string) (string, error) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	now := c.now()
+	if cache, ok := c.tokens[aud]; ok && now.Add(vapidRefresh).Before(cache.expires) {
+		return "vapid t=" + cache.token + ", k=" + c.publicKey, nil
+	}
+
+	expires := now.Add(vapidTTL)
+	claims := map[string]any{
+		"aud": aud,
+		"exp": expires.Unix(),
+	}
+	if c.subject != "" {
+		claims["sub"] = c.subject
+	}
+	payload, _ := json.Marshal(claims)
+
+	header := jws.NewHeaders()
+	_ = header.Set("typ", "JWT")
+
+	token, err := jws.Sign(payload, jws.WithKey(
+		jwa.ES256(), c.key, jws.WithProtectedHeaders(header),
+	))
+	if err != nil {
+		return "", fmt.Errorf("webpush: vapid; %v", err)
+	}
+
+	c.tokens[aud] = vapidToken{token: string(token), expires: expires}
+	return "vapid t=" + string(token) + ", k=" + c.publicKey, nil
+}
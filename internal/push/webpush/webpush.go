@@ -0,0 +1,295 @@
+// Package webpush implements the Web PUSH protocol sender
+// with VAPID authentication and "aes128gcm" message encryption.
+//
+// https://datatracker.ietf.org/doc/html/rfc8030
+// https://datatracker.ietf.org/doc/html/rfc8291
+// https://datatracker.ietf.org/doc/html/rfc8292
+package webpush
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/webitel/im-account-service/internal/push"
+	adminpb "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+	authpb "github.com/webitel/im-account-service/proto/gen/im/service/auth/v1"
+)
+
+// Proxy request header(s)
+const (
+	HeaderToken    = "X-Webitel-PUSH-Token"
+	HeaderEndpoint = "X-Webitel-PUSH-Endpoint"
+)
+
+// Default [TTL] of the message, if not specified ; 4 weeks.
+const DefaultTTL = 28 * 24 * time.Hour
+
+// Subscription of the browser PushManager ; PushSubscription.toJSON()
+// https://www.w3.org/TR/push-api/#dom-pushsubscription-tojson
+type Subscription struct {
+	Endpoint string `json:"endpoint"`
+	Keys     struct {
+		P256dh string `json:"p256dh"` // base64url
+		Auth   string `json:"auth"`   // base64url
+	} `json:"keys"`
+}
+
+// Token encodes the Web PUSH [sub]scription as the [push.Sender] device token.
+func Token(sub *authpb.WebPushSubscription) string {
+	var src Subscription
+	src.Endpoint = sub.GetEndpoint()
+	src.Keys.P256dh = base64.RawURLEncoding.EncodeToString(sub.GetKey().GetP256Dh())
+	src.Keys.Auth = base64.RawURLEncoding.EncodeToString(sub.GetKey().GetAuth())
+	data, _ := json.Marshal(src)
+	return string(data)
+}
+
+// ParseSubscription decodes the [Token] encoded subscription.
+func ParseSubscription(token string) (endpoint *url.URL, p256dh, auth []byte, err error) {
+	var src Subscription
+	if err = json.Unmarshal([]byte(token), &src); err != nil {
+		return nil, nil, nil, fmt.Errorf("webpush: subscription; %v", err)
+	}
+	endpoint, err = url.Parse(src.Endpoint)
+	if err != nil || endpoint.Host == "" || (endpoint.Scheme != "https" && endpoint.Scheme != "http") {
+		return nil, nil, nil, fmt.Errorf("webpush: subscription; invalid endpoint %q", src.Endpoint)
+	}
+	if p256dh, err = decodeBase64(src.Keys.P256dh); err != nil {
+		return nil, nil, nil, fmt.Errorf("webpush: subscription p256dh key; %v", err)
+	}
+	if auth, err = decodeBase64(src.Keys.Auth); err != nil {
+		return nil, nil, nil, fmt.Errorf("webpush: subscription auth secret; %v", err)
+	}
+	return endpoint, p256dh, auth, nil
+}
+
+// decodeBase64 of the URL (browser) or standard alphabet, padded or not.
+func decodeBase64(s string) ([]byte, error) {
+	s = strings.TrimRight(s, "=")
+	if strings.ContainsAny(s, "+/") {
+		return base64.RawStdEncoding.DecodeString(s)
+	}
+	return base64.RawURLEncoding.DecodeString(s)
+}
+
+// Client sends Web PUSH messages.
+type Client struct {
+	proxy  string // OPTIONAL ; relay URL
+	token  string // OPTIONAL ; [X-Webitel-PUSH-Token]
+	client *http.Client
+	vapid  *vapidSigner
+	now    func() time.Time
+}
+
+var _ push.Sender = (*Client)(nil)
+
+// Option of the Web PUSH Client.
+type Option func(c *Client)
+
+// WithHTTPClient to send requests with.
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Client) {
+		if client != nil {
+			c.client = client
+		}
+	}
+}
+
+// WithClock to be used instead of [time.Now].
+func WithClock(now func() time.Time) Option {
+	return func(c *Client) {
+		if now != nil {
+			c.now = now
+		}
+	}
+}
+
+// New Web PUSH Client of the App [config].
+// The [config.vapid] key pair is required.
+func New(config *adminpb.PushWebServiceClient, opts ...Option) (*Client, error) {
+
+	c := &Client{
+		proxy:  config.GetProxy(),
+		token:  string(config.GetToken()),
+		client: http.DefaultClient,
+		now:    time.Now,
+	}
+
+	for _, setup := range opts {
+		setup(c)
+	}
+
+	if c.proxy != "" {
+		if _, err := url.Parse(c.proxy); err != nil {
+			return nil, fmt.Errorf("webpush: proxy; %v", err)
+		}
+	}
+
+	vapid, err := newVAPIDSigner(config.GetVapid(), c.now)
+	if err != nil {
+		return nil, err
+	}
+	c.vapid = vapid
+
+	return c, nil
+}
+
+// Send [msg] to the [Token] encoded Web PUSH subscription.
+func (c *Client) Send(ctx context.Context, token string, msg *push.Message) *push.Result {
+
+	endpoint, p256dh, auth, err := ParseSubscription(token)
+	if err != nil {
+		return &push.Result{Outcome: push.Unregistered, Reason: "BadSubscription", Err: err}
+	}
+
+	body, err := json.Marshal(payload(msg))
+	if err != nil {
+		return &push.Result{Outcome: push.Failed, Err: err}
+	}
+	body, err = encrypt(body, p256dh, auth)
+	if err != nil {
+		return &push.Result{Outcome: push.Failed, Err: err}
+	}
+
+	// VAPID audience ; origin of the push service
+	authz, err := c.vapid.Authorization(endpoint.Scheme + "://" + endpoint.Host)
+	if err != nil {
+		return &push.Result{Outcome: push.Failed, Err: err}
+	}
+
+	target := endpoint.String()
+	if c.proxy != "" {
+		target = c.proxy
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, target, bytes.NewReader(body),
+	)
+	if err != nil {
+		return &push.Result{Outcome: push.Failed, Err: err}
+	}
+
+	header := req.Header
+	header.Set("Authorization", authz)
+	header.Set("Content-Type", "application/octet-stream")
+	header.Set("Content-Encoding", "aes128gcm")
+
+	ttl := msg.TTL
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	header.Set("TTL", strconv.FormatInt(int64(ttl/time.Second), 10))
+
+	switch {
+	case msg.Priority == push.PriorityHigh, msg.Call:
+		header.Set("Urgency", "high")
+	case msg.Silent():
+		header.Set("Urgency", "low")
+	default:
+		header.Set("Urgency", "normal")
+	}
+	if topicRegexp.MatchString(msg.Collapse) {
+		header.Set("Topic", msg.Collapse)
+	}
+
+	if c.proxy != "" {
+		header.Set(HeaderEndpoint, endpoint.String())
+		if c.token != "" {
+			header.Set(HeaderToken, c.token)
+		}
+	}
+
+	rsp, err := c.client.Do(req)
+	if err != nil {
+		return push.Failure(err)
+	}
+	defer rsp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(rsp.Body, 1<<16))
+	if err != nil {
+		return push.Failure(err)
+	}
+
+	if rsp.StatusCode == http.StatusCreated || rsp.StatusCode == http.StatusOK || rsp.StatusCode == http.StatusAccepted {
+		return &push.Result{
+			Outcome:  push.Delivered,
+			Status:   rsp.StatusCode,
+			Id:       rsp.Header.Get("Location"),
+			Response: data,
+		}
+	}
+
+	res := failure(rsp.StatusCode, data)
+	res.Response = data
+	res.RetryAfter = push.RetryAfter(rsp.Header, c.now())
+	return res
+}
+
+// Topic header ; max 32 characters of the URL-safe base64 alphabet
+var topicRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]{1,32}$`)
+
+// payload encodes the [msg] JSON data
+// to be handled by the client service worker [push] event.
+func payload(msg *push.Message) map[string]any {
+	res := map[string]any{}
+	if !msg.Silent() {
+		res["title"] = msg.Title
+		res["body"] = msg.Body
+	}
+	if len(msg.Data) > 0 {
+		res["data"] = msg.Data
+	}
+	if msg.Collapse != "" {
+		res["tag"] = msg.Collapse
+	}
+	if msg.Sound != "" {
+		res["sound"] = msg.Sound
+	}
+	if msg.Badge != nil {
+		res["badge"] = *msg.Badge
+	}
+	if msg.Category != "" {
+		res["category"] = msg.Category
+	}
+	return res
+}
+
+// failure maps the push service error response to the delivery Result.
+// https://datatracker.ietf.org/doc/html/rfc8030#section-5
+func failure(status int, data []byte) *push.Result {
+
+	res := &push.Result{
+		Outcome: push.Failed,
+		Status:  status,
+		Reason:  http.StatusText(status),
+	}
+
+	text := strings.TrimSpace(string(data))
+	if len(text) > 256 {
+		text = text[:256]
+	}
+	if text == "" {
+		text = http.StatusText(status)
+	}
+	res.Err = fmt.Errorf("webpush: %s", text)
+
+	switch {
+	case status == http.StatusNotFound || status == http.StatusGone:
+		// Subscription expired or unsubscribed
+		res.Outcome = push.Unregistered
+	case status == http.StatusTooManyRequests || status >= 500:
+		res.Outcome = push.Retry
+	}
+
+	return res
+}
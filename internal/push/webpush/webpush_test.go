@@ -0,0 +1,154 @@
+package webpush_test
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v3/jwa"
+	"github.com/lestrrat-go/jwx/v3/jws"
+	"github.com/webitel/im-account-service/internal/model"
+	"github.com/webitel/im-account-service/internal/push"
+	"github.com/webitel/im-account-service/internal/push/webpush"
+	adminpb "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+	authpb "github.com/webitel/im-account-service/proto/gen/im/service/auth/v1"
+)
+
+// decrypt the "aes128gcm" [body] with the user agent keys ; RFC 8291
+func decrypt(t *testing.T, body []byte, ua *ecdh.PrivateKey, auth []byte) []byte {
+	t.Helper()
+	salt, keyid := body[:16], body[21:21+int(body[20])]
+	asPublic, err := ecdh.P256().NewPublicKey(keyid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	secret, _ := ua.ECDH(asPublic)
+	info := "WebPush: info\x00" + string(ua.PublicKey().Bytes()) + string(keyid)
+	ikm, _ := hkdf.Key(sha256.New, secret, auth, info, 32)
+	prk, _ := hkdf.Extract(sha256.New, ikm, salt)
+	cek, _ := hkdf.Expand(sha256.New, prk, "Content-Encoding: aes128gcm\x00", 16)
+	nonce, _ := hkdf.Expand(sha256.New, prk, "Content-Encoding: nonce\x00", 12)
+	block, _ := aes.NewCipher(cek)
+	aead, _ := cipher.NewGCM(block)
+	plain, err := aead.Open(nil, nonce, body[21+len(keyid):], nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plain[len(plain)-1] != 0x02 {
+		t.Fatalf("record delimiter %x, want 02", plain[len(plain)-1])
+	}
+	return plain[:len(plain)-1]
+}
+
+func TestClientSend(t *testing.T) {
+
+	ua, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth := make([]byte, 16)
+	_, _ = rand.Read(auth)
+
+	vapid := model.GenerateVAPID()
+	vapid.Subject = "mailto:push@example.com"
+	vapidKey, _ := ecdsa.ParseUncompressedPublicKey(elliptic.P256(), vapid.GetPublicKey())
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /relay", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(webpush.HeaderToken) != "secret" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		endpoint := r.Header.Get(webpush.HeaderEndpoint)
+		// vapid t=<jwt>, k=<key>
+		params := strings.Split(strings.TrimPrefix(r.Header.Get("Authorization"), "vapid "), ", ")
+		claims, err := jws.Verify([]byte(strings.TrimPrefix(params[0], "t=")), jws.WithKey(jwa.ES256(), vapidKey))
+		if err != nil || params[1] != "k="+base64.RawURLEncoding.EncodeToString(vapid.GetPublicKey()) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var jwt struct {
+			Aud string `json:"aud"`
+			Sub string `json:"sub"`
+		}
+		_ = json.Unmarshal(claims, &jwt)
+		if !strings.HasPrefix(endpoint, jwt.Aud+"/") || jwt.Sub != vapid.Subject {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if r.Header.Get("TTL") != "60" || r.Header.Get("Urgency") != "high" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		var msg struct {
+			Title string `json:"title"`
+		}
+		_ = json.Unmarshal(decrypt(t, body, ua, auth), &msg)
+		if msg.Title != "Hello" {
+			t.Errorf("payload.title = %q, want Hello", msg.Title)
+		}
+		switch endpoint {
+		case "https://push.example.com/gone":
+			w.WriteHeader(http.StatusGone)
+		case "https://push.example.com/busy":
+			w.Header().Set("Retry-After", "7")
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			w.Header().Set("Location", "https://push.example.com/message/1")
+			w.WriteHeader(http.StatusCreated)
+		}
+	})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	client, err := webpush.New(&adminpb.PushWebServiceClient{
+		Proxy: srv.URL + "/relay",
+		Token: []byte("secret"),
+		Vapid: vapid,
+	}, webpush.WithHTTPClient(srv.Client()))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg := &push.Message{Title: "Hello", Body: "World", TTL: time.Minute, Priority: push.PriorityHigh}
+	for _, test := range []struct {
+		endpoint string
+		outcome  push.Outcome
+	}{
+		{"https://push.example.com/device", push.Delivered},
+		{"https://push.example.com/gone", push.Unregistered},
+		{"https://push.example.com/busy", push.Retry},
+	} {
+		token := webpush.Token(&authpb.WebPushSubscription{
+			Endpoint: test.endpoint,
+			Key: &authpb.WebPushSubscription_Key{
+				Auth:   auth,
+				P256Dh: ua.PublicKey().Bytes(),
+			},
+		})
+		res := client.Send(context.Background(), token, msg)
+		if res.Outcome != test.outcome {
+			t.Errorf("Send( %s ) = %s ; %s, want %s", test.endpoint, res.Outcome, res.Error(), test.outcome)
+		}
+		if test.outcome == push.Retry && res.RetryAfter != 7*time.Second {
+			t.Errorf("Send( %s ).RetryAfter = %s, want 7s", test.endpoint, res.RetryAfter)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/webitel/im-account-service/internal/model"
+)
+
+// AppSecretStore of the Application [client_secret](s).
+type AppSecretStore interface {
+	Search(SearchAppSecretRequest) ([]*model.ClientSecret, error)
+	Issue(IssueAppSecretRequest) (*model.ClientSecret, error)
+	Revoke(RevokeAppSecretRequest) (*model.ClientSecret, error)
+	Verify(VerifyAppSecretRequest) (*VerifyAppSecretResult, error)
+}
+
+type SearchAppSecretRequest struct {
+	context.Context
+	Dc    int64  // domain_id ; REQUIRED
+	AppId string // client_id ; REQUIRED
+	// Include expired and revoked secret(s) ?
+	All  bool
+	Date time.Time // now ; active at
+}
+
+type IssueAppSecretRequest struct {
+	context.Context
+	// NEW secret to store ; hash only
+	Secret *model.ClientSecret
+	// Expiration date of the current active secret(s) ; overlap window end.
+	// Secret(s) that already expire sooner are kept unchanged.
+	Expires time.Time
+}
+
+type RevokeAppSecretRequest struct {
+	context.Context
+	Dc    int64  // domain_id ; REQUIRED
+	AppId string // client_id ; REQUIRED
+	Id    string // secret ID
+	Date  time.Time
+}
+
+type VerifyAppSecretRequest struct {
+	context.Context
+	AppId  string // client_id ; REQUIRED
+	Secret string // plaintext ; OPTIONAL
+	Date   time.Time
+}
+
+type VerifyAppSecretResult struct {
+	// App has active secret(s) ?
+	// False: no [client_secret] required.
+	Required bool
+	// Secret that matched the given one ; [last_used_at] updated.
+	Match *model.ClientSecret
+}
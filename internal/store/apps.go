@@ -3,6 +3,7 @@ package store
 import (
 	"context"
 
+	"github.com/webitel/im-account-service/internal/graphql"
 	"github.com/webitel/im-account-service/internal/model"
 )
 
@@ -11,13 +12,25 @@ type AppStore interface {
 	Create(CreateAppRequest) (*model.Application, error)
 	Update(UpdateAppRequest) (*model.Application, error)
 	Revoke(RevokeAppRequest) (*model.ApplicationList, error)
+	History(SearchAppHistoryRequest) (*model.AppVersionList, error)
 	// Delete()
+
+	// SealSecrets seals the plaintext config secret(s) of the App(s)
+	// stored before the keyring was configured ; See [model.AppSecretFields].
+	SealSecrets(ctx context.Context) (int, error)
 }
 
 type SearchAppRequest struct {
 	context.Context
 	Dc int64  // domain_id
 	Id string // client_id
+	Q  string // name, about ; [*] wildcard
+
+	// Output field(s) ; See [model.ParseAppFields]
+	// Empty: ALL the field(s).
+	Fields graphql.Fields
+	// Order by field(s) ; See [model.ParseAppSort]
+	Sort graphql.Fields
 
 	Page int // offset
 	Size int // limit, per page
@@ -26,16 +39,36 @@ type SearchAppRequest struct {
 type CreateAppRequest struct {
 	context.Context
 	App *model.Application
+	By  model.Mutation // created_at ; by
 }
 
 type UpdateAppRequest struct {
 	context.Context
+	// App configuration to be stored.
+	// App.Version() MUST match the current one.
 	App *model.Application
+	By  model.Mutation // updated_at ; by
+	// Field path(s) changed ; See [model.AppDiff]
+	Diff []string
+	// Version restored from, if any
+	Restored int32
 }
 
 type RevokeAppRequest struct {
 	context.Context
+	Dc     int64 // domain_id ; REQUIRED
 	Id     []string
 	Reason error
 	Delete bool
+	By     model.Mutation // revoked_at ; by
+}
+
+type SearchAppHistoryRequest struct {
+	context.Context
+	Dc    int64  // domain_id ; REQUIRED
+	AppId string // client_id ; REQUIRED
+	Ver   int32  // OPTIONAL
+
+	Page int // offset
+	Size int // limit, per page
 }
@@ -0,0 +1,26 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/webitel/im-account-service/internal/model"
+)
+
+// EventOutboxStore of the account domain event record(s).
+// Record(s) are written by the session change transaction itself.
+type EventOutboxStore interface {
+	// Claim the pending record(s) due to publish ; in the [Id] order.
+	// Claimed record(s) [attempts] incremented and leased.
+	// Record(s) of the session leased earlier block the later one(s).
+	Claim(ClaimEventRequest) ([]*model.AccountEvent, error)
+	// Delete the published record(s).
+	Delete(ctx context.Context, ids []int64) error
+}
+
+type ClaimEventRequest struct {
+	context.Context
+	Date  time.Time     // now ; due at
+	Lease time.Duration // claimed record(s) lease
+	Size  int           // limit
+}
@@ -0,0 +1,267 @@
+package postgres
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/webitel/im-account-service/infra/db/pg"
+	"github.com/webitel/im-account-service/internal/model"
+	"github.com/webitel/im-account-service/internal/store"
+	"github.com/webitel/im-account-service/internal/store/postgres/pgtypex"
+)
+
+var _ store.AppSecretStore = (*AppSecretStore)(nil)
+
+type AppSecretStore struct {
+	db *pg.DB
+}
+
+func NewAppSecretStore(db *pg.DB) *AppSecretStore {
+	return &AppSecretStore{db: db}
+}
+
+// App secret record column(s) ; See [scanAppSecret]
+const appSecretColumns = `
+		s.dc, s.app_id, s.id
+	, s.hash, s.hint
+	, s.created_at, s.expires_at, s.last_used_at, s.revoked_at
+`
+
+// scanAppSecret decodes [appSecretColumns] row.
+func scanAppSecret(row pgx.Row) (*model.ClientSecret, error) {
+
+	var (
+		res     model.ClientSecret
+		created *time.Time
+	)
+
+	err := row.Scan(
+		&res.Dc, &res.AppId, &res.Id,
+		&res.Hash, &res.Hint,
+		pgtypex.ScanTimestamptz(&created),
+		pgtypex.ScanTimestamptz(&res.ExpiresAt),
+		pgtypex.ScanTimestamptz(&res.LastUsedAt),
+		pgtypex.ScanTimestamptz(&res.RevokedAt),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if created != nil {
+		res.CreatedAt = *created
+	}
+
+	return &res, nil
+}
+
+// appId returns the [client_id] query argument ; NOT Valid if malformed.
+func appId(vs string) (id pgtype.UUID) {
+	uid, err := uuid.Parse(vs)
+	if err != nil {
+		return // NOT Found !
+	}
+	return pgtype.UUID{Bytes: uid, Valid: true}
+}
+
+func (c *AppSecretStore) Search(req store.SearchAppSecretRequest) ([]*model.ClientSecret, error) {
+
+	date := req.Date
+	if date.IsZero() {
+		date = model.LocalTime.Now()
+	}
+
+	query, args := `
+	SELECT
+	`+appSecretColumns+`
+	FROM im_account.app_secret s
+	WHERE s.dc = @dc AND s.app_id = @app_id
+	`, pgx.NamedArgs{
+		"dc":     req.Dc,
+		"app_id": appId(req.AppId),
+	}
+
+	if !req.All {
+		args["date"] = pgtypex.TimestamptzValue(&date)
+		query += ` AND s.revoked_at ISNULL AND (s.expires_at ISNULL OR s.expires_at > @date)`
+	}
+
+	query += ` ORDER BY s.created_at DESC`
+
+	rows, err := c.db.Client().Query(
+		req.Context, query, args,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var list []*model.ClientSecret
+	for rows.Next() {
+		row, err := scanAppSecret(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, row)
+	}
+
+	return list, rows.Err()
+}
+
+// Issue stores NEW App secret and shortens the current active secret(s)
+// validity up to the [req.Expires] date ; overlap window.
+// Returns nil, nil if the App is not found or revoked.
+func (c *AppSecretStore) Issue(req store.IssueAppSecretRequest) (*model.ClientSecret, error) {
+
+	src := req.Secret
+	date := src.CreatedAt
+	if date.IsZero() {
+		date = model.LocalTime.Now()
+	}
+
+	query, args := `
+	WITH app AS (
+		SELECT app.dc, app.id
+		FROM im_account.app app
+		WHERE app.dc = @dc AND app.id = @app_id
+			AND app.revoked_at ISNULL
+	)
+	, rotate AS (
+		UPDATE im_account.app_secret s SET
+			expires_at = LEAST(s.expires_at, @expires)
+		FROM app
+		WHERE s.dc = app.dc AND s.app_id = app.id
+			AND s.revoked_at ISNULL
+			AND (s.expires_at ISNULL OR s.expires_at > @date)
+	)
+	INSERT INTO im_account.app_secret s
+	(
+		dc, app_id, id, hash, hint, created_at
+	)
+	SELECT
+		app.dc, app.id, @id, @hash, @hint, @date
+	FROM app
+	RETURNING
+	`+appSecretColumns, pgx.NamedArgs{
+		"dc":      src.Dc,
+		"app_id":  appId(src.AppId),
+		"id":      src.Id,
+		"hash":    src.Hash,
+		"hint":    src.Hint,
+		"date":    pgtypex.TimestamptzValue(&date),
+		"expires": pgtypex.TimestamptzValue(&req.Expires),
+	}
+
+	res, err := scanAppSecret(c.db.Client().QueryRow(
+		req.Context, query, args,
+	))
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil // App NOT Found ; revoked
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	res.Secret = src.Secret // show once !
+	return res, nil
+}
+
+// Revoke App secret immediately.
+// Returns nil, nil if not found or already revoked.
+func (c *AppSecretStore) Revoke(req store.RevokeAppSecretRequest) (*model.ClientSecret, error) {
+
+	date := req.Date
+	if date.IsZero() {
+		date = model.LocalTime.Now()
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, nil // NOT Found !
+	}
+
+	query, args := `
+	UPDATE im_account.app_secret s SET
+		revoked_at = @date
+	WHERE s.dc = @dc AND s.app_id = @app_id AND s.id = @id
+		AND s.revoked_at ISNULL
+	RETURNING
+	`+appSecretColumns, pgx.NamedArgs{
+		"dc":     req.Dc,
+		"app_id": appId(req.AppId),
+		"id":     pgtype.UUID{Bytes: id, Valid: true},
+		"date":   pgtypex.TimestamptzValue(&date),
+	}
+
+	res, err := scanAppSecret(c.db.Client().QueryRow(
+		req.Context, query, args,
+	))
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil // NOT Found !
+	}
+
+	return res, err
+}
+
+// Verify the given [req.Secret] against the App active secret(s).
+// Matched secret [last_used_at] is updated.
+func (c *AppSecretStore) Verify(req store.VerifyAppSecretRequest) (*store.VerifyAppSecretResult, error) {
+
+	date := req.Date
+	if date.IsZero() {
+		date = model.LocalTime.Now()
+	}
+
+	var res store.VerifyAppSecretResult
+	args := pgx.NamedArgs{
+		"app_id": appId(req.AppId),
+		"date":   pgtypex.TimestamptzValue(&date),
+	}
+
+	if req.Secret != "" {
+		args["hash"] = model.ClientSecretHash(req.Secret)
+		match, err := scanAppSecret(c.db.Client().QueryRow(
+			req.Context, `
+			UPDATE im_account.app_secret s SET
+				last_used_at = @date
+			WHERE s.app_id = @app_id AND s.hash = @hash
+				AND s.revoked_at ISNULL
+				AND (s.expires_at ISNULL OR s.expires_at > @date)
+			RETURNING
+			`+appSecretColumns, args,
+		))
+		if err == nil {
+			res.Match = match
+			res.Required = true
+			return &res, nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	err := c.db.Client().QueryRow(
+		req.Context, `
+		SELECT EXISTS(
+			SELECT FROM im_account.app_secret s
+			WHERE s.app_id = @app_id
+				AND s.revoked_at ISNULL
+				AND (s.expires_at ISNULL OR s.expires_at > @date)
+		)
+		`, args,
+	).Scan(&res.Required)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
@@ -1,29 +1,40 @@
 package postgres
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgtype/zeronull"
 	"github.com/webitel/im-account-service/infra/db/pg"
+	"github.com/webitel/im-account-service/infra/secrets"
+	"github.com/webitel/im-account-service/internal/graphql"
 	"github.com/webitel/im-account-service/internal/model"
 	"github.com/webitel/im-account-service/internal/store"
 	"github.com/webitel/im-account-service/internal/store/postgres/pgtypex"
 	v1 "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 var _ store.AppStore = (*AppStore)(nil)
 
 type AppStore struct {
-	db *pg.DB
+	db   *pg.DB
+	keys *secrets.Keyring // OPTIONAL ; nil - plaintext secrets (!)
 }
 
-func NewAppStore(db *pg.DB) *AppStore {
+func NewAppStore(db *pg.DB, keys *secrets.Keyring) *AppStore {
 	return &AppStore{
-		db: db,
+		db:   db,
+		keys: keys,
 	}
 }
 
@@ -31,9 +42,7 @@ func (c *AppStore) Search(req store.SearchAppRequest) (*model.ApplicationList, e
 
 	query, args := `
 	SELECT
-		dc, id
-	, "name", about
-	, config
+	`+appSelect(req.Fields)+`
 	FROM im_account.app
 	`, pgx.NamedArgs{
 		// "dc": req.Dc,
@@ -68,11 +77,17 @@ func (c *AppStore) Search(req store.SearchAppRequest) (*model.ApplicationList, e
 			Bytes: id, Valid: true, // given, but MAY be invalid, e.g. 00000000-0000-...
 		}
 	}
+	if req.Q != "" {
+		where = append(where, "(app.\"name\" ILIKE @q OR app.about ILIKE @q)")
+		args["q"] = substringPattern(req.Q)
+	}
 	// endregion: filter(s)
 
 	if len(where) > 0 {
 		query += " WHERE " + strings.Join(where, " AND ")
 	}
+
+	query += " ORDER BY " + appOrderBy(req.Sort)
 	if offset > 0 {
 		query += fmt.Sprintf(" OFFSET %d", offset)
 	}
@@ -90,38 +105,12 @@ func (c *AppStore) Search(req store.SearchAppRequest) (*model.ApplicationList, e
 
 	defer rows.Close()
 
-	var (
-		// row *model.Application
-		row *v1.Application
-		res model.ApplicationList
-	)
-
+	var res model.ApplicationList
 	res.Page = max(1, req.Page) // default: 1
 
 	for rows.Next() {
 
-		// row = &model.Application{}
-		row = &v1.Application{}
-		err := rows.Scan(
-			// dc
-			&row.Dc,
-			// id
-			&row.Id,
-			// name
-			&row.Name,
-			// about
-			&row.About,
-			// config
-			pgtypex.ScanBytesFunc(func(src []byte) error {
-				enc := &protojsonCodec
-				err := enc.Unmarshal(src, row)
-				if err != nil {
-					return err
-				}
-				return nil
-			}),
-		)
-
+		row, err := c.scanApp(rows)
 		if err != nil {
 			return nil, err
 		}
@@ -140,6 +129,168 @@ func (c *AppStore) Search(req store.SearchAppRequest) (*model.ApplicationList, e
 	return &res, nil
 }
 
+// App config section(s) available for the [appSelect] projection.
+var appSections = []string{
+	"client", "service", "account", "contacts",
+}
+
+// appSelect returns [appColumns] with the App config
+// reduced to the section(s) of the requested [fields].
+// Sealed secrets are decoded only if the "service" section is requested.
+func appSelect(fields graphql.Fields) string {
+	if len(fields) == 0 {
+		return appColumns
+	}
+	config := []string{
+		"'block', app.config->'block'",
+	}
+	for _, name := range appSections {
+		if fields.Has(name) {
+			config = append(config, fmt.Sprintf(
+				"'%[1]s', app.config->'%[1]s'", name,
+			))
+		}
+	}
+	secrets := "NULL::jsonb"
+	if fields.Has("service") {
+		secrets = "app.secrets"
+	}
+	return `
+		app.dc, app.id
+	, app."name", app.about
+	, jsonb_build_object(` + strings.Join(config, ", ") + `)
+	, app.ver, app.created_at, app.updated_at, app.revoked_at
+	, ` + secrets + `
+`
+}
+
+// appOrderBy returns the ORDER BY clause for the [sort] field(s).
+// Field name prefix: "-" or "!" means descending order.
+func appOrderBy(sort graphql.Fields) string {
+	var order []string
+	for _, q := range sort {
+		name, desc := q.Name, false
+		switch name[0] {
+		case '-', '!':
+			name, desc = name[1:], true
+		case '+':
+			name = name[1:]
+		}
+		if !slices.Contains(model.AppSortFields, name) {
+			continue // NOT sortable ; validated
+		}
+		expr := fmt.Sprintf("app.%q", name)
+		if desc {
+			expr += " DESC"
+		}
+		order = append(order, expr)
+	}
+	// stable paging
+	return strings.Join(append(order, "app.id"), ", ")
+}
+
+// substringPattern returns ILIKE pattern for the [q] search term.
+// The [*] wildcard means any substring ; otherwise, [q] may occur anywhere.
+func substringPattern(q string) string {
+	q = strings.NewReplacer(
+		`\`, `\\`, "%", `\%`, "_", `\_`,
+	).Replace(q)
+	if strings.Contains(q, "*") {
+		return strings.ReplaceAll(q, "*", "%")
+	}
+	return "%" + q + "%"
+}
+
+// App record column(s) ; See [scanApp]
+const appColumns = `
+		app.dc, app.id
+	, app."name", app.about
+	, app.config
+	, app.ver, app.created_at, app.updated_at, app.revoked_at
+	, app.secrets
+`
+
+// scanApp decodes [appColumns] row,
+// followed by the [more] column(s) destination, if any.
+func (c *AppStore) scanApp(rows pgx.Rows, more ...any) (*v1.Application, error) {
+
+	var (
+		row     = &v1.Application{}
+		rec     v1.Application // dc, id, name, about
+		ver     int32
+		created *time.Time
+		updated *time.Time
+		revoked *time.Time
+		sealed  *secrets.Envelope
+	)
+
+	err := rows.Scan(append([]any{
+		// dc
+		&rec.Dc,
+		// id
+		&rec.Id,
+		// name
+		&rec.Name,
+		// about
+		(*zeronull.Text)(&rec.About),
+		// config
+		pgtypex.ScanBytesFunc(func(src []byte) error {
+			enc := &protojsonCodec
+			err := enc.Unmarshal(src, row)
+			if err != nil {
+				return err
+			}
+			return nil
+		}),
+		// ver
+		&ver,
+		// created_at
+		pgtypex.ScanTimestamptz(&created),
+		// updated_at
+		pgtypex.ScanTimestamptz(&updated),
+		// revoked_at
+		pgtypex.ScanTimestamptz(&revoked),
+		// secrets
+		&sealed,
+	}, more...)...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// [NOTE]: config scan resets the whole row
+	row.Dc = rec.Dc
+	row.Id = rec.Id
+	row.Name = rec.Name
+	row.About = rec.About
+
+	if sealed != nil {
+		if c.keys == nil {
+			return nil, fmt.Errorf("app( %s ); secrets: keyring not configured", row.GetId())
+		}
+		data, err := c.keys.Open([]byte(row.GetId()), sealed)
+		if err != nil {
+			return nil, fmt.Errorf("app( %s ); %v", row.GetId(), err)
+		}
+		model.SetAppSecrets(row, data)
+	}
+
+	row.Ver = ver
+	row.CreatedAt = appTimestamp(created)
+	row.UpdatedAt = appTimestamp(updated)
+
+	if revoked != nil {
+		if row.Block == nil {
+			row.Block = &v1.Revocation{}
+		}
+		row.Block.Date = appTimestamp(revoked)
+	} else {
+		row.Block = nil // NOT revoked !
+	}
+
+	return row, nil
+}
+
 var protojsonCodec = struct {
 	protojson.UnmarshalOptions
 	protojson.MarshalOptions
@@ -162,47 +313,500 @@ var protojsonCodec = struct {
 	},
 }
 
+// appConfig encodes [src] App as (config, secrets) jsonb source(s).
+// Omits the record-level (bookkeeping) field(s).
+// Secret field(s) are sealed into the envelope, if keyring configured.
+func (c *AppStore) appConfig(src *v1.Application) (config []byte, sealed *secrets.Envelope, err error) {
+	src = proto.CloneOf(src)
+	src.Ver = 0
+	src.CreatedAt = 0
+	src.UpdatedAt = 0
+	if c.keys != nil {
+		data := model.AppSecrets(src, true)
+		if len(data) > 0 {
+			sealed, err = c.keys.Seal([]byte(src.GetId()), data)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	enc := &protojsonCodec
+	config, err = enc.Marshal(src)
+	return config, sealed, err
+}
+
+func appTimestamp(date *time.Time) int64 {
+	if date == nil {
+		return 0
+	}
+	return model.Timestamp.Time(*date)
+}
+
+// appHistory CTE stores the version snapshot of the [app] CTE row(s) changed.
+// Named args: @op, @diff, @restored, @date, @by, @by_name ; See [changedBy]
+const appHistory = `
+	, history AS (
+		INSERT INTO im_account.app_history
+		(
+			dc, app_id, ver, op, diff, restored
+		, "name", about, config, secrets, revoked_at
+		, changed_at, changed_by, changed_by_name
+		)
+		SELECT
+			app.dc, app.id, app.ver, @op, @diff::text[], nullif(@restored::int4, 0)
+		, app."name", app.about, app.config, app.secrets, app.revoked_at
+		, @date, nullif(@by::text, ''), nullif(@by_name::text, '')
+		FROM app
+	)
+`
+
+// changedBy sets the [appHistory] author named [args].
+// Returns the change date ; default: now.
+func changedBy(args pgx.NamedArgs, by model.Mutation) time.Time {
+	date := by.Date
+	if date.IsZero() {
+		date = model.LocalTime.Now()
+	}
+	args["date"] = pgtypex.TimestamptzValue(&date)
+	args["by"] = by.UserId
+	args["by_name"] = by.UserName
+	if _, ok := args["restored"]; !ok {
+		args["restored"] = 0
+	}
+	return date
+}
+
 func (c *AppStore) Create(req store.CreateAppRequest) (*model.Application, error) {
 
 	src := req.App.Proto()
-	enc := &protojsonCodec
-	jsonb, err := enc.Marshal(src)
+	jsonb, sealed, err := c.appConfig(src)
 	if err != nil {
 		return nil, err
 	}
 
 	query, args := `
-	INSERT INTO im_account.app
-	(
-		dc, id, "name", about, config
-	)
-	VALUES
-	(
-		@dc, @id, @name, @about, @config
+	WITH app AS (
+		INSERT INTO im_account.app
+		(
+			dc, id, "name", about, config, secrets, created_at
+		)
+		VALUES
+		(
+			@dc, @id, @name, @about, @config, @secrets, @date
+		)
+		RETURNING *
 	)
+	`+appHistory+`
+	SELECT app.ver, app.created_at FROM app
 	`, pgx.NamedArgs{
-		"dc":     src.GetDc(),
-		"id":     src.GetId(),
-		"name":   src.GetName(),
-		"about":  src.GetAbout(),
-		"config": jsonb,
+		"dc":      src.GetDc(),
+		"id":      src.GetId(),
+		"name":    src.GetName(),
+		"about":   src.GetAbout(),
+		"config":  jsonb,
+		"secrets": sealed,
+		// history
+		"op":   model.AppCreate,
+		"diff": model.AppDiff(nil, req.App),
 	}
 
-	_, err = c.db.Client().Exec(
+	changedBy(args, req.By)
+
+	var created *time.Time
+	err = c.db.Client().QueryRow(
 		req.Context, query, args,
+	).Scan(
+		&src.Ver, pgtypex.ScanTimestamptz(&created),
 	)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return req.App, nil
+	src.CreatedAt = appTimestamp(created)
+	return model.ProtoApplication(src), nil
 }
 
+// Update App configuration if it's [ver]sion still matches the given one.
+// Returns nil, nil if the App is not found or has been modified since.
 func (c *AppStore) Update(req store.UpdateAppRequest) (*model.Application, error) {
-	panic("not implemented") // TODO: Implement
+
+	src := req.App.Proto()
+	jsonb, sealed, err := c.appConfig(src)
+	if err != nil {
+		return nil, err
+	}
+
+	op := model.AppUpdate
+	if req.Restored > 0 {
+		op = model.AppRestore
+	}
+
+	query, args := `
+	WITH app AS (
+		UPDATE im_account.app app SET
+			"name" = @name
+		, about = @about
+		, config = @config
+		, secrets = @secrets
+		, updated_at = @date
+		, ver = app.ver + 1
+		WHERE app.dc = @dc AND app.id = @id AND app.ver = @ver
+		RETURNING app.*
+	)
+	`+appHistory+`
+	SELECT app.ver, app.created_at, app.updated_at FROM app
+	`, pgx.NamedArgs{
+		"dc":      src.GetDc(),
+		"id":      src.GetId(),
+		"ver":     src.GetVer(),
+		"name":    src.GetName(),
+		"about":   src.GetAbout(),
+		"config":  jsonb,
+		"secrets": sealed,
+		// history
+		"op":       op,
+		"diff":     req.Diff,
+		"restored": req.Restored,
+	}
+
+	changedBy(args, req.By)
+
+	var created, updated *time.Time
+	err = c.db.Client().QueryRow(
+		req.Context, query, args,
+	).Scan(
+		&src.Ver,
+		pgtypex.ScanTimestamptz(&created),
+		pgtypex.ScanTimestamptz(&updated),
+	)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil // NOT Found ; modified
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	src.CreatedAt = appTimestamp(created)
+	src.UpdatedAt = appTimestamp(updated)
+	return model.ProtoApplication(src), nil
+}
+
+// SealSecrets seals the plaintext config secret(s) of the App(s)
+// stored before the keyring was configured. Keeps the App version.
+// Returns the number of the App(s) sealed ; No-op if keyring not configured.
+func (c *AppStore) SealSecrets(ctx context.Context) (int, error) {
+
+	if c.keys == nil {
+		return 0, nil // plaintext (!)
+	}
+
+	count := 0
+	for _, table := range []struct {
+		query  string // [appColumns] row(s) NOT sealed
+		update string // Named args: @dc, @id, @ver, @config, @secrets
+	}{
+		// Current version(s)
+		{
+			query: `
+			SELECT
+			` + appColumns + `
+			FROM im_account.app
+			WHERE app.secrets ISNULL
+			`,
+			update: `
+			UPDATE im_account.app SET
+			  config = @config
+			, secrets = @secrets
+			WHERE dc = @dc AND id = @id AND ver = @ver AND secrets ISNULL
+			`,
+		},
+		// Version history snapshot(s)
+		{
+			query: `
+			SELECT
+				h.dc, h.app_id
+			, h."name", h.about
+			, h.config
+			, h.ver, NULL::timestamptz, h.changed_at, h.revoked_at
+			, h.secrets
+			FROM im_account.app_history h
+			WHERE h.secrets ISNULL
+			`,
+			update: `
+			UPDATE im_account.app_history SET
+			  config = @config
+			, secrets = @secrets
+			WHERE dc = @dc AND app_id = @id AND ver = @ver AND secrets ISNULL
+			`,
+		},
+	} {
+		sealed, err := c.sealSecrets(ctx, table.query, table.update)
+		count += sealed
+		if err != nil {
+			return count, err
+		}
+	}
+
+	return count, nil
 }
 
+// sealSecrets seals the [query] row(s) plaintext secret(s) with the [update] statement.
+func (c *AppStore) sealSecrets(ctx context.Context, query, update string) (int, error) {
+
+	rows, err := c.db.Client().Query(ctx, query)
+
+	if err != nil {
+		return 0, err
+	}
+
+	var list []*v1.Application
+	for rows.Next() {
+		row, err := c.scanApp(rows)
+		if err != nil {
+			rows.Close()
+			return 0, err
+		}
+		if len(model.AppSecrets(row, false)) > 0 {
+			list = append(list, row)
+		}
+	}
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, src := range list {
+
+		jsonb, sealed, err := c.appConfig(src)
+		if err != nil {
+			return count, err
+		}
+
+		// Concurrently updated ? Sealed already !
+		res, err := c.db.Client().Exec(ctx, update, pgx.NamedArgs{
+			"dc":      src.GetDc(),
+			"id":      src.GetId(),
+			"ver":     src.GetVer(),
+			"config":  jsonb,
+			"secrets": sealed,
+		})
+
+		if err != nil {
+			return count, err
+		}
+
+		count += int(res.RowsAffected())
+	}
+
+	return count, nil
+}
+
+// Revoke (block) or Delete App(s) by given [client_id](s).
+// Revocation invalidates all the App session(s) access token(s)
+// and records the session_revoked event(s) ; See [im_account.event_outbox].
+// Delete removes all the App session(s) ; ON DELETE CASCADE.
+// Returns App(s) affected.
 func (c *AppStore) Revoke(req store.RevokeAppRequest) (*model.ApplicationList, error) {
-	panic("not implemented") // TODO: Implement
+
+	ids := make([]pgtype.UUID, 0, len(req.Id))
+	for _, vs := range req.Id {
+		id, err := uuid.Parse(vs)
+		if err != nil {
+			continue // NOT Found !
+		}
+		ids = append(ids, pgtype.UUID{
+			Bytes: id, Valid: true,
+		})
+	}
+
+	var res model.ApplicationList
+	res.Page = 1
+
+	if len(ids) == 0 {
+		return &res, nil
+	}
+
+	var (
+		query string
+		args  = pgx.NamedArgs{
+			"dc": req.Dc,
+			"id": ids,
+		}
+		date = changedBy(args, req.By)
+	)
+
+	if req.Delete {
+		query = `
+		DELETE FROM im_account.app
+		WHERE app.dc = @dc AND app.id = ANY(@id)
+		RETURNING
+		` + appColumns
+	} else {
+
+		block := &v1.Revocation{
+			Date: model.Timestamp.Time(date),
+		}
+		if req.Reason != nil {
+			block.Reason = status.Convert(req.Reason).Proto()
+		}
+
+		enc := &protojsonCodec
+		jsonb, err := enc.Marshal(block)
+		if err != nil {
+			return nil, err
+		}
+
+		args["block"] = jsonb
+		args["op"] = model.AppRevoke
+		args["diff"] = []string{"block"}
+		// Session(s) revoked ; See [im_account.event_outbox]
+		args["event"] = model.AccountSessionRevoked
+		args["reason"] = model.AccountRevokedApp
+		query = `
+		WITH app AS (
+			UPDATE im_account.app app SET
+				revoked_at = @date
+			, config = jsonb_set(coalesce(app.config, '{}'), '{block}', @block::jsonb)
+			, ver = app.ver + 1
+			WHERE app.dc = @dc AND app.id = ANY(@id)
+				AND app.revoked_at ISNULL
+			RETURNING app.*
+		)
+		` + appHistory + `
+		, tokens AS (
+			UPDATE im_account.session_token t SET
+				revoked_at = @date
+			FROM im_account.session s, app
+			WHERE s.app_id = app.id AND s.dc = app.dc
+				AND t.id = s.id AND t.revoked_at ISNULL
+			RETURNING t.id
+		)
+		, events AS (
+			INSERT INTO im_account.event_outbox
+			(
+				dc, type, session_id, app_id, contact_id, data
+			)
+			SELECT
+				s.dc, @event, s.id, s.app_id, s.contact_id
+			, im_account.session_event_data(s, jsonb_build_object('reason', @reason::text))
+			FROM im_account.session s
+			JOIN tokens ON tokens.id = s.id
+			ORDER BY s.id
+		)
+		SELECT
+		` + appColumns + `
+		FROM app
+		`
+	}
+
+	rows, err := c.db.Client().Query(
+		req.Context, query, args,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		row, err := c.scanApp(rows)
+		if err != nil {
+			return nil, err
+		}
+		res.Data = append(res.Data, model.ProtoApplication(row))
+	}
+
+	return &res, rows.Err()
+}
+
+// History of the App configuration version(s) ; latest first.
+func (c *AppStore) History(req store.SearchAppHistoryRequest) (*model.AppVersionList, error) {
+
+	query, args := `
+	SELECT
+		h.dc, h.app_id
+	, h."name", h.about
+	, h.config
+	, h.ver, app.created_at, h.changed_at, h.revoked_at
+	, h.secrets
+	, h.op, h.diff, coalesce(h.restored, 0)
+	, h.changed_at, coalesce(h.changed_by, ''), coalesce(h.changed_by_name, '')
+	FROM im_account.app_history h
+	JOIN im_account.app app ON app.dc = h.dc AND app.id = h.app_id
+	WHERE h.dc = @dc AND h.app_id = @app_id
+	`, pgx.NamedArgs{
+		"dc":     req.Dc,
+		"app_id": appId(req.AppId),
+	}
+
+	limit, offset := req.Size, 0
+	if req.Page > 1 && req.Size > 0 {
+		offset = (req.Page - 1) * req.Size
+	}
+
+	if req.Ver > 0 {
+		limit = 1
+		query += " AND h.ver = @ver"
+		args["ver"] = req.Ver
+	}
+
+	query += " ORDER BY h.ver DESC"
+	if offset > 0 {
+		query += fmt.Sprintf(" OFFSET %d", offset)
+	}
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", (limit + 1))
+	}
+
+	rows, err := c.db.Client().Query(
+		req.Context, query, args,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var res model.AppVersionList
+	res.Page = max(1, req.Page) // default: 1
+
+	for rows.Next() {
+
+		var (
+			rec     model.AppVersion
+			changed *time.Time
+		)
+
+		row, err := c.scanApp(rows,
+			&rec.Op, &rec.Diff, &rec.Restored,
+			pgtypex.ScanTimestamptz(&changed),
+			&rec.UserId, &rec.UserName,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		rec.Ver = row.GetVer()
+		rec.App = model.ProtoApplication(row)
+		if changed != nil {
+			rec.Date = *changed
+		}
+
+		if 0 < limit && limit == len(res.Data) {
+			res.Next = &rec
+			break // for
+		}
+
+		res.Data = append(res.Data, &rec)
+	}
+
+	return &res, rows.Err()
 }
@@ -0,0 +1,115 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/webitel/im-account-service/infra/db/pg"
+	"github.com/webitel/im-account-service/internal/model"
+	"github.com/webitel/im-account-service/internal/store"
+	"github.com/webitel/im-account-service/internal/store/postgres"
+)
+
+func TestRevokeAppSessionEvents(t *testing.T) {
+
+	var (
+		db   = newTestDB(t)
+		apps = postgres.NewAppStore(db, nil)
+		ctx  = context.Background()
+		now  = time.Now()
+
+		app   = testApp(t, db, "app")
+		other = testApp(t, db, "other")
+
+		active  = testSession(t, db, "alice", "phone", "", now)
+		revoked = testSession(t, db, "bob", "phone", "", now)   // token revoked before
+		kept    = testSession(t, db, "carol", "phone", "", now) // other App
+	)
+
+	testAppToken(t, db, active, app, time.Time{})
+	testAppToken(t, db, revoked, app, now.Add(-time.Hour))
+	testAppToken(t, db, kept, other, time.Time{})
+
+	// Session(s) INSERT [sign_in] event(s) ; NOT of interest
+	if _, err := db.Client().Exec(ctx, `DELETE FROM im_account.event_outbox`); err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := apps.Revoke(store.RevokeAppRequest{
+		Context: ctx,
+		Dc:      1,
+		Id:      []string{app},
+		By:      model.Mutation{Date: now},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Data) != 1 {
+		t.Fatalf("Revoke() = %d app(s), want 1", len(list.Data))
+	}
+
+	rows, err := db.Client().Query(ctx, `
+	SELECT type, session_id::text, app_id::text, contact_id, data->>'reason'
+	FROM im_account.event_outbox
+	ORDER BY id
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	var events int
+	for ; rows.Next(); events++ {
+		var typeOf, session, appId, contact, reason string
+		if err = rows.Scan(&typeOf, &session, &appId, &contact, &reason); err != nil {
+			t.Fatal(err)
+		}
+		if typeOf != model.AccountSessionRevoked || session != active || appId != app ||
+			contact == "" || reason != model.AccountRevokedApp {
+			t.Errorf("Revoke() event = { type: %s, session: %s, app: %s, contact: %s, reason: %s }, want %s of the session( %s )",
+				typeOf, session, appId, contact, reason, model.AccountSessionRevoked, active)
+		}
+	}
+	if err = rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if events != 1 {
+		t.Errorf("Revoke() recorded %d event(s), want 1 ; active session only", events)
+	}
+}
+
+// testApp inserts the [name] App ; Returns the App [client_id].
+func testApp(t *testing.T, db *pg.DB, name string) string {
+	var id string
+	err := db.Client().QueryRow(context.Background(), `
+	INSERT INTO im_account.app (dc, "name", config)
+	VALUES (1, $1, '{}')
+	RETURNING id::text
+	`, name,
+	).Scan(&id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
+
+// testAppToken authorizes the [session] via the [app] with the access token ; [revoked]: OPTIONAL.
+func testAppToken(t *testing.T, db *pg.DB, session, app string, revoked time.Time) {
+	var date any // NULL
+	if !revoked.IsZero() {
+		date = revoked
+	}
+	_, err := db.Client().Exec(context.Background(), `
+	WITH s AS (
+		UPDATE im_account.session SET app_id = $2
+		WHERE id = $1
+	)
+	INSERT INTO im_account.session_token (id, token, revoked_at)
+	VALUES ($1, $1::text, $3)
+	`, session, app, date,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
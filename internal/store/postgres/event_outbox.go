@@ -0,0 +1,179 @@
+package postgres
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/webitel/im-account-service/infra/db/pg"
+	"github.com/webitel/im-account-service/internal/model"
+	"github.com/webitel/im-account-service/internal/store"
+	"github.com/webitel/im-account-service/internal/store/postgres/pgtypex"
+)
+
+var _ store.EventOutboxStore = (*EventOutboxStore)(nil)
+
+type EventOutboxStore struct {
+	db *pg.DB
+}
+
+func NewEventOutboxStore(db *pg.DB) *EventOutboxStore {
+	return &EventOutboxStore{db: db}
+}
+
+// Account event record column(s) ; See [scanAccountEvent]
+const accountEventColumns = `
+		q.id, q.dc, q.type
+	, q.session_id::text, coalesce(q.app_id::text, ''), q.contact_id
+	, q.data, q.attempts, q.created_at
+`
+
+// accountEventData is the [data] jsonb of the event record ;
+// See [im_account.session_event_data] function.
+type accountEventData struct {
+	Name      string          `json:"name"`
+	IP        string          `json:"ip"`
+	DeviceId  string          `json:"device_id"`
+	UserAgent string          `json:"user_agent"`
+	PushType  string          `json:"push_type"`
+	Push      json.RawMessage `json:"push"`
+	Reason    string          `json:"reason"`
+}
+
+// scanAccountEvent decodes [accountEventColumns] row.
+func scanAccountEvent(row pgx.Row) (*model.AccountEvent, error) {
+
+	var (
+		res     model.AccountEvent
+		created *time.Time
+	)
+
+	err := row.Scan(
+		&res.Id, &res.Dc, &res.Type,
+		&res.SessionId, &res.AppId,
+		scanContactId(&res.Contact),
+		pgtypex.ScanBytesFunc(func(src []byte) error {
+			var data accountEventData
+			err := json.Unmarshal(src, &data)
+			if err != nil {
+				return err
+			}
+			res.Name = data.Name
+			res.IP = data.IP
+			res.DeviceId = data.DeviceId
+			res.UserAgent = data.UserAgent
+			res.PushType = data.PushType
+			res.Reason = data.Reason
+			if len(data.Push) > 0 {
+				jsonbEnc := &protojsonCodec
+				res.Push = &model.PushToken{}
+				return jsonbEnc.Unmarshal(data.Push, res.Push)
+			}
+			return nil
+		}),
+		&res.Attempts,
+		pgtypex.ScanTimestamptz(&created),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if created != nil {
+		res.CreatedAt = *created
+	}
+
+	return &res, nil
+}
+
+// Claim the pending record(s) due at [req.Date] ; in the [Id] order.
+// Concurrent relay(s) skip the record(s) locked by each other.
+// Record(s) of the session, leased earlier (in flight or failed), block the later one(s).
+func (c *EventOutboxStore) Claim(req store.ClaimEventRequest) ([]*model.AccountEvent, error) {
+
+	date := req.Date
+	if date.IsZero() {
+		date = model.LocalTime.Now()
+	}
+	lease := date.Add(req.Lease)
+
+	query, args := `
+	WITH claim AS
+	(
+		SELECT q.id
+		FROM im_account.event_outbox q
+		WHERE q.next_at <= @date
+		AND NOT EXISTS
+		(
+			SELECT 1 FROM im_account.event_outbox p
+			WHERE p.session_id = q.session_id
+			AND p.id < q.id AND p.next_at > @date
+		)
+		ORDER BY q.id
+		LIMIT @size
+		FOR UPDATE SKIP LOCKED
+	)
+	UPDATE im_account.event_outbox q SET
+		attempts = q.attempts + 1
+	, next_at = @lease
+	FROM claim
+	WHERE q.id = claim.id
+	RETURNING
+	`+accountEventColumns, pgx.NamedArgs{
+		"date":  pgtypex.TimestamptzValue(&date),
+		"lease": pgtypex.TimestamptzValue(&lease),
+		"size":  max(1, req.Size),
+	}
+
+	rows, err := c.db.Client().Query(
+		req.Context, query, args,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var list []*model.AccountEvent
+	for rows.Next() {
+		rec, err := scanAccountEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, rec)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// UPDATE .. RETURNING order is NOT guaranteed
+	slices.SortFunc(list, func(a, b *model.AccountEvent) int {
+		return cmp.Compare(a.Id, b.Id)
+	})
+
+	return list, nil
+}
+
+// Delete the published record(s).
+func (c *EventOutboxStore) Delete(ctx context.Context, ids []int64) error {
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := c.db.Client().Exec(
+		ctx, `
+		DELETE FROM im_account.event_outbox q
+		WHERE q.id = ANY(@ids::int8[])
+		`, pgx.NamedArgs{
+			"ids": ids,
+		},
+	)
+
+	return err
+}
@@ -1,6 +1,9 @@
 package postgres
 
 import (
+	"context"
+	"log/slog"
+
 	"github.com/webitel/im-account-service/internal/store"
 	"go.uber.org/fx"
 )
@@ -9,5 +12,26 @@ var Module = fx.Module(
 	"store", fx.Provide(
 		fx.Annotate(NewAppStore, fx.As(new(store.AppStore))),
 		fx.Annotate(NewSessionStore, fx.As(new(store.SessionStore))),
+		fx.Annotate(NewAppSecretStore, fx.As(new(store.AppSecretStore))),
+		fx.Annotate(NewPushOutboxStore, fx.As(new(store.PushOutboxStore))),
+		fx.Annotate(NewUpdateOutboxStore, fx.As(new(store.UpdateOutboxStore))),
+		fx.Annotate(NewEventOutboxStore, fx.As(new(store.EventOutboxStore))),
 	),
+	fx.Invoke(sealAppSecrets),
 )
+
+// sealAppSecrets seals the plaintext App secret(s) at startup, once the keyring configured.
+func sealAppSecrets(apps store.AppStore, logger *slog.Logger, runtime fx.Lifecycle) {
+	runtime.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			count, err := apps.SealSecrets(ctx)
+			if err != nil {
+				return err
+			}
+			if count > 0 {
+				logger.Info("[ SECRETS ] plaintext app secrets sealed", "count", count)
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,118 @@
+package postgres_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
+	"github.com/pressly/goose/v3/database"
+
+	"github.com/webitel/im-account-service/infra/db/pg"
+	"github.com/webitel/im-account-service/migrations"
+)
+
+// newTestDB connects the [POSTGRES_TEST_DSN] scratch database ; skipped if not set.
+// The schema is migrated up and ALL the im_account table(s) are truncated (!)
+func newTestDB(t *testing.T) *pg.DB {
+	dsn := os.Getenv("POSTGRES_TEST_DSN")
+	if dsn == "" {
+		t.Skip("POSTGRES_TEST_DSN not set")
+	}
+
+	ctx := context.Background()
+	conf, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn := stdlib.OpenDB(*conf.ConnConfig)
+	defer conn.Close()
+
+	version, err := database.NewStore(database.DialectPostgres, "im_account_schema_version")
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider, err := goose.NewProvider(goose.Dialect(""), conn, migrations.EmbedMigrations,
+		goose.WithStore(version), goose.WithGoMigrations(migrations.GoMigrations(nil)...),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = provider.Up(ctx); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	db, err := pg.New(ctx, slog.New(slog.DiscardHandler), dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(db.Client().Close)
+	pg.SetDefault(db) // [ContactId] codec
+
+	_, err = db.Client().Exec(ctx, `
+	TRUNCATE im_account.app, im_account.session, im_account.session_token, im_account.event_outbox CASCADE
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
+
+// Issuer of the test contact(s)
+const testIssuer = "https://idp.example.com"
+
+// testSession inserts the session of the [contact] device with the [push] token jsonb ; OPTIONAL.
+// The [contact] is the subject of the [testIssuer] ; internal id: "id-" + [contact].
+// Returns the session id.
+func testSession(t *testing.T, db *pg.DB, contact, device, push string, created time.Time) string {
+	var (
+		id    string
+		token any // NULL
+	)
+	if push != "" {
+		token = push
+	}
+	err := db.Client().QueryRow(context.Background(), `
+	INSERT INTO im_account.session
+	(
+		dc, ip, "name", device_id, contact_id, push_token, created_at
+	)
+	VALUES
+	(
+		1, '127.0.0.1', 'test', $1
+	, ROW(1, 'id-' || $2::text, $3::text, $2::text)::im_account.contact_id::text
+	, $4::jsonb, $5
+	)
+	RETURNING id::text
+	`, device, contact, testIssuer, token, created,
+	).Scan(&id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
+
+// testPushToken returns the [session] device PUSH token(s) jsonb ; nil: NULL.
+func testPushToken(t *testing.T, db *pg.DB, session string) map[string]any {
+	var token map[string]any
+	err := db.Client().QueryRow(context.Background(), `
+	SELECT push_token FROM im_account.session WHERE id = $1
+	`, session,
+	).Scan(&token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+// testToken returns the [token] registration of the [typeOf] provider [field] value ; nil: none.
+func testToken(token map[string]any, typeOf, field string) any {
+	entry, _ := token[typeOf].(map[string]any)
+	return entry[field]
+}
@@ -0,0 +1,409 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/webitel/im-account-service/infra/db/pg"
+	"github.com/webitel/im-account-service/internal/model"
+	"github.com/webitel/im-account-service/internal/store"
+	"github.com/webitel/im-account-service/internal/store/postgres/pgtypex"
+	v1 "github.com/webitel/im-account-service/proto/gen/im/service/auth/v1"
+)
+
+var _ store.PushOutboxStore = (*PushOutboxStore)(nil)
+
+type PushOutboxStore struct {
+	db *pg.DB
+}
+
+func NewPushOutboxStore(db *pg.DB) *PushOutboxStore {
+	return &PushOutboxStore{db: db}
+}
+
+// PUSH delivery record column(s) ; See [scanPushDelivery]
+const pushDeliveryColumns = `
+		q.dc, q.id
+	, q.session_id::text, coalesce(q.app_id::text, ''), q.provider
+	, q.push_token, q.message
+	, q.state, q.attempts, q.next_at
+	, coalesce(q.status, 0), coalesce(q.reason, ''), coalesce(q.error, '')
+	, q.created_at, q.updated_at
+`
+
+// scanPushDelivery decodes [pushDeliveryColumns] row.
+func scanPushDelivery(row pgx.Row) (*model.PushDelivery, error) {
+
+	var (
+		res      model.PushDelivery
+		next     *time.Time
+		created  *time.Time
+		updated  *time.Time
+		jsonbEnc = &protojsonCodec
+	)
+
+	err := row.Scan(
+		&res.Dc, &res.Id,
+		&res.SessionId, &res.AppId, &res.Provider,
+		pgtypex.ScanBytesFunc(func(src []byte) error {
+			res.Token = &model.PushToken{}
+			return jsonbEnc.Unmarshal(src, res.Token)
+		}),
+		pgtypex.ScanBytesFunc(func(src []byte) error {
+			res.Message = &v1.Notification{}
+			return jsonbEnc.Unmarshal(src, res.Message)
+		}),
+		&res.State, &res.Attempts,
+		pgtypex.ScanTimestamptz(&next),
+		&res.Status, &res.Reason, &res.Error,
+		pgtypex.ScanTimestamptz(&created),
+		pgtypex.ScanTimestamptz(&updated),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if next != nil {
+		res.NextAt = *next
+	}
+	if created != nil {
+		res.CreatedAt = *created
+	}
+	if updated != nil {
+		res.UpdatedAt = *updated
+	}
+
+	return &res, nil
+}
+
+// Enqueue NEW pending record(s) due immediately.
+// Record(s) [Id] assigned in the given [list] order.
+func (c *PushOutboxStore) Enqueue(ctx context.Context, list []*model.PushDelivery) error {
+
+	if len(list) == 0 {
+		return nil
+	}
+
+	var (
+		size     = len(list)
+		dcs      = make([]int64, 0, size)
+		sessions = make([]string, 0, size)
+		apps     = make([]string, 0, size)
+		provider = make([]string, 0, size)
+		tokens   = make([]string, 0, size)
+		messages = make([]string, 0, size)
+		jsonbEnc = &protojsonCodec
+		date     = model.LocalTime.Now()
+	)
+
+	for _, rec := range list {
+		token, err := jsonbEnc.Marshal(rec.Token)
+		if err != nil {
+			return err
+		}
+		message, err := jsonbEnc.Marshal(rec.Message)
+		if err != nil {
+			return err
+		}
+		dcs = append(dcs, rec.Dc)
+		sessions = append(sessions, rec.SessionId)
+		apps = append(apps, rec.AppId)
+		provider = append(provider, rec.Provider)
+		tokens = append(tokens, string(token))
+		messages = append(messages, string(message))
+	}
+
+	query, args := `
+	WITH input AS MATERIALIZED
+	(
+		SELECT
+			nextval(pg_get_serial_sequence('im_account.push_outbox', 'id')) id
+		, t.*
+		FROM UNNEST(
+			@dc::int8[], @session_id::text[], @app_id::text[]
+		, @provider::text[], @push_token::text[], @message::text[]
+		) WITH ORDINALITY t(dc, session_id, app_id, provider, push_token, message, n)
+	)
+	, created AS
+	(
+		INSERT INTO im_account.push_outbox
+		(
+			dc, id, session_id, app_id, provider, push_token, message
+		, state, attempts, next_at, created_at
+		)
+		SELECT
+			t.dc, t.id, t.session_id::uuid, NULLIF(t.app_id, '')::uuid
+		, t.provider, t.push_token::jsonb, t.message::jsonb
+		, 'pending', 0, @date, @date
+		FROM input t
+	)
+	SELECT t.id FROM input t ORDER BY t.n
+	`, pgx.NamedArgs{
+		"dc":         dcs,
+		"session_id": sessions,
+		"app_id":     apps,
+		"provider":   provider,
+		"push_token": tokens,
+		"message":    messages,
+		"date":       pgtypex.TimestamptzValue(&date),
+	}
+
+	rows, err := c.db.Client().Query(
+		ctx, query, args,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+
+	var i int
+	for ; rows.Next(); i++ {
+		rec := list[i]
+		err = rows.Scan(&rec.Id)
+		if err != nil {
+			return err
+		}
+		rec.State = model.PushPending
+		rec.NextAt = date
+		rec.CreatedAt = date
+	}
+
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	if i != size {
+		return fmt.Errorf("push.enqueue(): %d of %d record(s) stored", i, size)
+	}
+
+	return nil
+}
+
+// Claim the pending record(s) due at [req.Date].
+// Concurrent worker(s) skip the record(s) locked by each other.
+func (c *PushOutboxStore) Claim(req store.ClaimPushRequest) ([]*model.PushDelivery, error) {
+
+	date := req.Date
+	if date.IsZero() {
+		date = model.LocalTime.Now()
+	}
+	lease := date.Add(req.Lease)
+
+	query, args := `
+	WITH claim AS
+	(
+		SELECT q.id
+		FROM im_account.push_outbox q
+		WHERE q.state = 'pending' AND q.next_at <= @date
+		ORDER BY q.next_at
+		LIMIT @size
+		FOR UPDATE SKIP LOCKED
+	)
+	UPDATE im_account.push_outbox q SET
+		attempts = q.attempts + 1
+	, next_at = @lease
+	FROM claim
+	WHERE q.id = claim.id
+	RETURNING
+	`+pushDeliveryColumns, pgx.NamedArgs{
+		"date":  pgtypex.TimestamptzValue(&date),
+		"lease": pgtypex.TimestamptzValue(&lease),
+		"size":  max(1, req.Size),
+	}
+
+	rows, err := c.db.Client().Query(
+		req.Context, query, args,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var list []*model.PushDelivery
+	for rows.Next() {
+		rec, err := scanPushDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, rec)
+	}
+
+	return list, rows.Err()
+}
+
+// Update the record(s) state, next attempt date and the last attempt result.
+func (c *PushOutboxStore) Update(ctx context.Context, list []*model.PushDelivery) error {
+
+	if len(list) == 0 {
+		return nil
+	}
+
+	var (
+		size    = len(list)
+		ids     = make([]int64, 0, size)
+		states  = make([]string, 0, size)
+		nextAt  = make([]time.Time, 0, size)
+		status  = make([]int32, 0, size)
+		reason  = make([]string, 0, size)
+		details = make([]string, 0, size)
+		updated = make([]time.Time, 0, size)
+	)
+
+	for _, rec := range list {
+		date := rec.UpdatedAt
+		if date.IsZero() {
+			date = model.LocalTime.Now()
+		}
+		ids = append(ids, rec.Id)
+		states = append(states, rec.State)
+		nextAt = append(nextAt, rec.NextAt.UTC())
+		status = append(status, int32(rec.Status))
+		reason = append(reason, rec.Reason)
+		details = append(details, rec.Error)
+		updated = append(updated, date.UTC())
+	}
+
+	_, err := c.db.Client().Exec(
+		ctx, `
+		UPDATE im_account.push_outbox q SET
+			state = t.state
+		, next_at = t.next_at
+		, status = NULLIF(t.status, 0)
+		, reason = NULLIF(t.reason, '')
+		, error = NULLIF(t.error, '')
+		, updated_at = t.updated_at
+		FROM UNNEST(
+			@id::int8[], @state::text[], @next_at::timestamptz[]
+		, @status::int4[], @reason::text[], @error::text[]
+		, @updated_at::timestamptz[]
+		) t(id, state, next_at, status, reason, error, updated_at)
+		WHERE q.id = t.id
+		`, pgx.NamedArgs{
+			"id":         ids,
+			"state":      states,
+			"next_at":    nextAt,
+			"status":     status,
+			"reason":     reason,
+			"error":      details,
+			"updated_at": updated,
+		},
+	)
+
+	return err
+}
+
+// Delete the delivered (or obsolete) record(s).
+func (c *PushOutboxStore) Delete(ctx context.Context, ids []int64) error {
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := c.db.Client().Exec(
+		ctx, `
+		DELETE FROM im_account.push_outbox q
+		WHERE q.id = ANY(@ids::int8[])
+		`, pgx.NamedArgs{
+			"ids": ids,
+		},
+	)
+
+	return err
+}
+
+// Purge the dead-letter record(s) failed before the given date.
+func (c *PushOutboxStore) Purge(ctx context.Context, before time.Time) (int64, error) {
+
+	res, err := c.db.Client().Exec(
+		ctx, `
+		DELETE FROM im_account.push_outbox q
+		WHERE q.state = 'failed' AND q.updated_at < @before
+		`, pgx.NamedArgs{
+			"before": pgtypex.TimestamptzValue(&before),
+		},
+	)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected(), nil
+}
+
+// Search the delivery record(s) ; latest first.
+func (c *PushOutboxStore) Search(req store.SearchPushRequest) (*model.PushDeliveryList, error) {
+
+	query, args := `
+	SELECT
+	`+pushDeliveryColumns+`
+	FROM im_account.push_outbox q
+	WHERE q.dc = @dc
+	`, pgx.NamedArgs{
+		"dc": req.Dc,
+	}
+
+	if req.AppId != "" {
+		args["app_id"] = appId(req.AppId)
+		query += " AND q.app_id = @app_id"
+	}
+	if req.SessionId != "" {
+		args["session_id"] = appId(req.SessionId) // UUID ; NOT Valid if malformed
+		query += " AND q.session_id = @session_id"
+	}
+	if len(req.State) > 0 {
+		args["state"] = req.State
+		query += " AND q.state = ANY(@state::name[])"
+	}
+
+	limit, offset := req.Size, 0
+	if limit <= 0 {
+		limit = store.SearchPushDefaultSize
+	}
+	limit = min(limit, store.SearchPushMaxSize)
+	if req.Page > 1 {
+		offset = (req.Page - 1) * limit
+	}
+
+	query += " ORDER BY q.id DESC"
+	if offset > 0 {
+		query += fmt.Sprintf(" OFFSET %d", offset)
+	}
+	query += fmt.Sprintf(" LIMIT %d", (limit + 1))
+
+	rows, err := c.db.Client().Query(
+		req.Context, query, args,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var res model.PushDeliveryList
+	res.Page = max(1, req.Page) // default: 1
+
+	for rows.Next() {
+
+		rec, err := scanPushDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		if limit == len(res.Data) {
+			res.Next = rec
+			break // for
+		}
+
+		res.Data = append(res.Data, rec)
+	}
+
+	return &res, rows.Err()
+}
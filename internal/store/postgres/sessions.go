@@ -5,8 +5,12 @@ import (
 	"encoding/json"
 	goerrors "errors"
 	"fmt"
+	"net"
+	"net/netip"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -18,7 +22,6 @@ import (
 	"github.com/webitel/im-account-service/internal/model"
 	"github.com/webitel/im-account-service/internal/store"
 	"github.com/webitel/im-account-service/internal/store/postgres/pgtypex"
-	v1 "github.com/webitel/im-account-service/proto/gen/im/service/auth/v1"
 )
 
 type SessionStore struct {
@@ -47,6 +50,8 @@ func (c *SessionStore) Search(req store.ListSessionRequest) (*model.SessionList,
 	, a.contact_id
 	, a.metadata
 	, a.created_at
+	, a.last_active_at, a.last_ip
+	, array_to_string(a.ip_history, ',')
 	-----------------------------
 	, z.type, z.token, z.refresh, z.scope
 	, z.rotated_at, z.expires_at
@@ -70,6 +75,16 @@ func (c *SessionStore) Search(req store.ListSessionRequest) (*model.SessionList,
 		args["id"] = pgtype.UUID{Bytes: id, Valid: true} // even -if- not: to filter result to none with ZERO UUID
 		where = append(where, "a.id = @id")
 	}
+	if len(req.Ids) > 0 {
+		ids := make([]pgtype.UUID, 0, len(req.Ids))
+		for _, v := range req.Ids {
+			if id, err := uuid.Parse(v); err == nil {
+				ids = append(ids, pgtype.UUID{Bytes: id, Valid: true})
+			}
+		}
+		args["ids"] = ids
+		where = append(where, "a.id = ANY(@ids)")
+	}
 	if req.Token != "" {
 		args["token"] = req.Token
 		where = append(where, "z.token = @token")
@@ -84,9 +99,33 @@ func (c *SessionStore) Search(req store.ListSessionRequest) (*model.SessionList,
 		args["device_id"] = req.DeviceId
 		where = append(where, "a.device_id = @device_id")
 	}
-	if req.ContactId != nil {
-		args["contact_id"] = ((*ContactId)(req.ContactId))
-		where = append(where, "a.contact_id = @contact_id")
+	if contact := req.ContactId; contact != nil {
+		if contact.Dc > 0 && contact.Id != "" && contact.Iss != "" && contact.Sub != "" {
+			args["contact_id"] = ((*ContactId)(req.ContactId))
+			where = append(where, "a.contact_id = @contact_id")
+		} else {
+			// lookup by the given component(s) only
+			const cid = "(a.contact_id::im_account.contact_id)"
+			if contact.Dc > 0 {
+				args["contact_dc"] = contact.Dc
+				where = append(where, cid+".dc = @contact_dc")
+			}
+			if contact.Id != "" {
+				args["contact_cid"] = contact.Id
+				where = append(where, cid+".id = @contact_cid")
+			}
+			if contact.Iss != "" {
+				args["contact_iss"] = contact.Iss
+				where = append(where, cid+".iss = @contact_iss")
+			}
+			if contact.Sub != "" {
+				args["contact_sub"] = contact.Sub
+				where = append(where, cid+".sub = @contact_sub")
+			}
+			if !contact.IsValid() {
+				where = append(where, "false") // none
+			}
+		}
 	}
 	if req.PushToken != nil {
 		cond := "NOTNULL"
@@ -142,26 +181,8 @@ func (c *SessionStore) Search(req store.ListSessionRequest) (*model.SessionList,
 			// dpush_token
 			func(row *model.Authorization) any {
 				return pgtypex.ScanBytesFunc(func(src []byte) error {
-
-					if len(src) == 0 {
-						row.Device.Push = nil
-						return nil
-					}
-
-					data := row.Device.Push
 					row.Device.Push = nil
-					if data == nil {
-						data = &v1.PUSHSubscription{}
-					}
-
-					jsonbCodec := &protojsonCodec
-					err := jsonbCodec.Unmarshal(src, data)
-					if err != nil {
-						return err
-					}
-
-					row.Device.Push = data
-					return nil
+					return scanPushTokens(src, &row.Device.Push)
 				})
 			},
 			// contact_id
@@ -171,6 +192,30 @@ func (c *SessionStore) Search(req store.ListSessionRequest) (*model.SessionList,
 			// created_at
 			func(row *model.Authorization) any { return (*zeronull.Timestamptz)(&row.Date) },
 			// func(row *model.Authorization) any { dst := &row.Date; return pgtypex.ScanTimestamptz(&dst) },
+			// last_active_at
+			func(row *model.Authorization) any { return (*zeronull.Timestamptz)(&row.Active) },
+			// last_ip
+			func(row *model.Authorization) any {
+				return pgtypex.NetIPScanFunc(func(src netip.Prefix) error {
+					row.Device.Addr = nil
+					if src.IsValid() {
+						row.Device.Addr = &net.IPAddr{IP: src.Addr().AsSlice()}
+					}
+					return nil
+				})
+			},
+			// ip_history
+			func(row *model.Authorization) any {
+				return pgtypex.ScanBytesFunc(func(src []byte) error {
+					row.Device.From = nil
+					for _, addr := range strings.Split(string(src), ",") {
+						if ip := net.ParseIP(addr); ip != nil {
+							row.Device.From = append(row.Device.From, ip)
+						}
+					}
+					return nil
+				})
+			},
 			// ------------------------------------------------------------------------------------ //
 			// grant.type
 			func(row *model.Authorization) any {
@@ -585,13 +630,86 @@ func (c *SessionStore) SearchV2(req store.ListSessionRequest) (*model.SessionLis
 	return &res, nil
 }
 
+// sessionOtherUids matches the session [o] contact to any of the @other_uids
+const sessionOtherUids = `EXISTS
+		(
+			SELECT true
+			FROM jsonb_to_recordset(@other_uids::jsonb) u(id text, iss text, sub text)
+			, LATERAL (SELECT o.contact_id::im_account.contact_id AS c) x
+			WHERE (x.c).id = nullif(u.id, '')
+			   OR ((x.c).iss = nullif(u.iss, '') AND (x.c).sub = nullif(u.sub, ''))
+		)`
+
+// otherUidsValue encodes [list] of contact(s) as jsonb array of {id, iss, sub} record(s).
+func otherUidsValue(list []*model.ContactId) json.RawMessage {
+	type contactId struct {
+		Id  string `json:"id,omitempty"`
+		Iss string `json:"iss,omitempty"`
+		Sub string `json:"sub,omitempty"`
+	}
+	uids := make([]contactId, 0, len(list))
+	for _, e := range list {
+		if e.IsValid() {
+			uids = append(uids, contactId{Id: e.Id, Iss: e.Iss, Sub: e.Sub})
+		}
+	}
+	data, _ := json.Marshal(uids)
+	return data
+}
+
+// pushTokenValue encodes the device [src] registration as jsonb {"<type>": token} entry.
+// The [key] option strips the token optional data ; See [model.PushTokenKey].
+func pushTokenValue(src *model.PushToken, key bool) (json.RawMessage, error) {
+	typeOf := model.PushType(src)
+	if key {
+		src = model.PushTokenKey(src)
+	}
+	jsonbCodec := &protojsonCodec
+	data, err := jsonbCodec.Marshal(src)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(map[string]json.RawMessage{
+		typeOf: data,
+	})
+}
+
+// scanPushTokens decodes the jsonb {"<type>": token} registration(s).
+func scanPushTokens(src []byte, dst *model.PushTokens) error {
+	if len(src) == 0 {
+		return nil
+	}
+	var data map[string]json.RawMessage
+	err := json.Unmarshal(src, &data)
+	if err != nil {
+		return err
+	}
+	jsonbCodec := &protojsonCodec
+	for typeOf, entry := range data {
+		token := &model.PushToken{}
+		err = jsonbCodec.Unmarshal(entry, token)
+		if err != nil {
+			return err
+		}
+		if *dst == nil {
+			*dst = make(model.PushTokens, len(data))
+		}
+		(*dst)[typeOf] = token
+	}
+	return nil
+}
+
 // RegisterDevice PUSH [req.Token] for given session [req.Authorization.Id]
 // If not specified try to create NEW session for ( device + contact ) authorization
 // without [session.token] access grant and register device PUSH [req.Token] for it
 func (c *SessionStore) RegisterDevice(req store.RegisterDeviceRequest) error {
 
-	jsonbCodec := &protojsonCodec
-	jsonbToken, err := jsonbCodec.Marshal(req.Token)
+	// Replace the registration of the same type only
+	jsonbToken, err := pushTokenValue(req.Token, false)
+	if err != nil {
+		return err
+	}
+	jsonbTokenKey, err := pushTokenValue(req.Token, true)
 	if err != nil {
 		return err
 	}
@@ -630,7 +748,7 @@ func (c *SessionStore) RegisterDevice(req store.RegisterDeviceRequest) error {
 		UPDATE im_account.session SET
 		  ip = coalesce(@ip, ip)   -- last address
 		, user_agent = @user_agent -- last descriptor
-		, push_token = @push_token -- register
+		, push_token = coalesce(push_token, '{}') || @push_token::jsonb -- register
 		WHERE id = @id -- authorized by internal session.id
 		RETURNING id -- true
 	)
@@ -656,6 +774,29 @@ func (c *SessionStore) RegisterDevice(req store.RegisterDeviceRequest) error {
 		-- DO UPDATE SET --
 		RETURNING id -- generated
 	)
+	, others AS
+	(
+		-- Register for the other signed-in contact(s) on this device
+		UPDATE im_account.session o
+		SET push_token = coalesce(o.push_token, '{}') || @push_token::jsonb
+		WHERE o.dc = @dc
+		  AND o.device_id = @device_id
+		  AND o.app_id IS NOT DISTINCT FROM @app_id::uuid
+		  AND o.id IS DISTINCT FROM @id::uuid
+		  AND `+sessionOtherUids+`
+		RETURNING o.id
+	)
+	, moved AS
+	(
+		-- Move token off every other session in the same app
+		UPDATE im_account.session m
+		SET push_token = NULLIF(m.push_token - @push_type::text, '{}')
+		WHERE m.push_token @> @push_token_key
+		  AND m.app_id IS NOT DISTINCT FROM @app_id::uuid
+		  AND m.id IS DISTINCT FROM @id::uuid
+		  AND m.id NOT IN (SELECT id FROM others)
+		RETURNING m.id
+	)
 	SELECT
 		(SELECT true FROM updated)
 	, (SELECT id FROM created)
@@ -671,8 +812,11 @@ func (c *SessionStore) RegisterDevice(req store.RegisterDeviceRequest) error {
 		// "metadata":   metadata, // json.Marshal
 		"created_at": pgtypex.TimestamptzValue(&session.Date),
 
-		"push_token": json.RawMessage(jsonbToken), // protojson.Marshal
-		// "other_uids": ((pgtype.FlatArray[*ContactId])(req.OtherUids)),
+		"push_token": jsonbToken, // {type: protojson.Marshal}
+		"push_type":  model.PushType(req.Token),
+		"other_uids": otherUidsValue(req.OtherUids),
+
+		"push_token_key": jsonbTokenKey,
 	}
 
 	// PERFORM
@@ -707,32 +851,56 @@ func (c *SessionStore) RegisterDevice(req store.RegisterDeviceRequest) error {
 
 func (c *SessionStore) UnregisterDevice(req store.UnregisterDeviceRequest) error {
 
+	// Remove the registration of the same type only
 	jsonbCodec := &protojsonCodec
 	jsonbToken, err := jsonbCodec.Marshal(req.Token)
 	if err != nil {
 		return err
 	}
+	jsonbTokenKey, err := pushTokenValue(req.Token, true)
+	if err != nil {
+		return err
+	}
 
 	query, args := `
 	WITH auth AS
 	(
-		SELECT push_token
+		SELECT dc, id, app_id, device_id
+		, push_token -> @push_type::text AS push_token
 		FROM im_account.session
 		WHERE id = @session_id
 	)
 	, done AS
 	(
 		UPDATE im_account.session
-		SET push_token = NULL
-		WHERE id = @session_id AND push_token = @push_token
+		SET push_token = NULLIF(push_token - @push_type::text, '{}')
+		WHERE id = @session_id AND push_token -> @push_type::text = @push_token::jsonb
 		-- RETURNING true
 	)
+	, others AS
+	(
+		-- Unregister for the other signed-in contact(s) on this device
+		UPDATE im_account.session o
+		SET push_token = NULLIF(o.push_token - @push_type::text, '{}')
+		FROM auth a
+		WHERE a.push_token = @push_token::jsonb
+		  AND o.dc = a.dc
+		  AND o.device_id = a.device_id
+		  AND o.app_id IS NOT DISTINCT FROM a.app_id
+		  AND o.id <> a.id
+		  AND o.push_token @> @push_token_key
+		  AND `+sessionOtherUids+`
+	)
 	SELECT 
-	  (SELECT NULLIF(push_token, @push_token) ISNULL FROM auth)
+	  (SELECT NULLIF(push_token, @push_token::jsonb) ISNULL FROM auth)
 	-- , (SELECT count(*) FROM done)
 	`, pgx.NamedArgs{
 		"session_id": req.SessionId,                   // UUID
 		"push_token": json.RawMessage(jsonbToken), // protojson.Marshal
+		"push_type":  model.PushType(req.Token),
+		"other_uids": otherUidsValue(req.OtherUids),
+
+		"push_token_key": jsonbTokenKey,
 	}
 
 	// PERFORM
@@ -763,3 +931,167 @@ func (c *SessionStore) UnregisterDevice(req store.UnregisterDeviceRequest) error
 	// [ OK ]
 	return nil
 }
+
+func (c *SessionStore) ExpirePushTokens(req store.ExpirePushTokenRequest) (int64, error) {
+
+	var (
+		query string
+		args  = pgx.NamedArgs{}
+	)
+
+	switch {
+	case len(req.Tokens) > 0:
+		{
+			// Match by value ; whatever the session(s)
+			tokens := make([]string, 0, len(req.Tokens))
+			types := make([]string, 0, len(req.Tokens))
+			for _, src := range req.Tokens {
+				if src.GetToken() == nil {
+					continue
+				}
+				data, err := pushTokenValue(src, true)
+				if err != nil {
+					return 0, err
+				}
+				tokens = append(tokens, string(data))
+				types = append(types, model.PushType(src))
+			}
+			if len(tokens) == 0 {
+				return 0, nil
+			}
+			args["tokens"] = tokens
+			args["types"] = types
+			query = `
+			WITH matched AS
+			(
+				SELECT s.id, array_agg(DISTINCT t.type) types
+				FROM UNNEST(@tokens::text[]::jsonb[], @types::text[]) t(token, type)
+				, LATERAL (
+					SELECT id FROM im_account.session
+					WHERE push_token @> t.token
+				) s
+				GROUP BY s.id
+			)
+			, expired AS
+			(
+				UPDATE im_account.session a
+				SET push_token = NULLIF(a.push_token - matched.types, '{}')
+				FROM matched
+				WHERE a.id = matched.id
+				RETURNING a.id
+			)
+			SELECT count(*) FROM expired
+			`
+		}
+	case !req.IdleSince.IsZero():
+		{
+			args["idle_since"] = req.IdleSince
+			args["size"] = zeronull.Int8(req.Size) // NULL ; ALL
+			query = `
+			WITH idle AS
+			(
+				SELECT a.id
+				FROM im_account.session a
+				LEFT JOIN im_account.session_token z ON a.id = z.id -- [1:1]
+				WHERE a.push_token NOTNULL
+				  AND greatest(a.created_at, z.rotated_at, a.last_active_at) < @idle_since
+				LIMIT @size
+				FOR UPDATE OF a SKIP LOCKED
+			)
+			, expired AS
+			(
+				UPDATE im_account.session a
+				SET push_token = NULL
+				FROM idle
+				WHERE a.id = idle.id
+				RETURNING a.id
+			)
+			SELECT count(*) FROM expired
+			`
+		}
+	default:
+		return 0, errors.BadRequest(
+			errors.Message("device: expire( tokens | idle_since ) required"),
+		)
+	}
+
+	// PERFORM
+	var count int64
+	err := c.db.Client().QueryRow(
+		req.Context, query, args,
+	).Scan(
+		&count,
+	)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// Touch records the session(s) activity with a single statement ; the stale record(s) are ignored.
+// Record(s) are sorted by the session [Id] ; concurrent node(s) flush in the same order.
+func (c *SessionStore) Touch(ctx context.Context, list []*model.SessionActivity) error {
+
+	list = slices.DeleteFunc(slices.Clone(list), func(rec *model.SessionActivity) bool {
+		return rec == nil || rec.Id == ""
+	})
+	if len(list) == 0 {
+		return nil
+	}
+	slices.SortFunc(list, func(a, b *model.SessionActivity) int {
+		return strings.Compare(a.Id, b.Id)
+	})
+
+	var (
+		size = len(list)
+		ids  = make([]pgtype.UUID, 0, size)
+		date = make([]time.Time, 0, size)
+		ips  = make([]string, 0, size)
+		from = make([]string, 0, size) // comma-separated ; "" keep
+	)
+
+	for _, rec := range list {
+		id, err := uuid.Parse(rec.Id)
+		if err != nil {
+			return err
+		}
+		var (
+			ip   string
+			hist = make([]string, 0, len(rec.From))
+		)
+		if len(rec.IP) > 0 {
+			ip = rec.IP.String()
+		}
+		for _, addr := range rec.From {
+			hist = append(hist, addr.String())
+		}
+		ids = append(ids, pgtype.UUID{Bytes: id, Valid: true})
+		date = append(date, rec.Date.UTC())
+		ips = append(ips, ip)
+		from = append(from, strings.Join(hist, ","))
+	}
+
+	// Fixed number of the array parameter(s) ; any batch size
+	_, err := c.db.Client().Exec(
+		ctx, `
+		UPDATE im_account.session a SET
+		  last_active_at = t.date
+		, last_ip = coalesce(NULLIF(t.ip, '')::inet, a.last_ip)
+		, ip_history = coalesce(string_to_array(NULLIF(t.ip_history, ''), ',')::inet[], a.ip_history)
+		FROM UNNEST(
+		  @id::uuid[], @date::timestamptz[], @ip::text[], @ip_history::text[]
+		) t(id, date, ip, ip_history)
+		WHERE a.id = t.id
+		  AND (a.last_active_at ISNULL OR a.last_active_at < t.date)
+		`, pgx.NamedArgs{
+			"id":         ids,
+			"date":       date,
+			"ip":         ips,
+			"ip_history": from,
+		},
+	)
+
+	return err
+}
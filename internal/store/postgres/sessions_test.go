@@ -0,0 +1,217 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/webitel/im-account-service/internal/model"
+	"github.com/webitel/im-account-service/internal/store"
+	"github.com/webitel/im-account-service/internal/store/postgres"
+	authpb "github.com/webitel/im-account-service/proto/gen/im/service/auth/v1"
+)
+
+func TestExpirePushTokens(t *testing.T) {
+
+	var (
+		db       = newTestDB(t)
+		sessions = postgres.NewSessionStore(db)
+		ctx      = context.Background()
+		now      = time.Now()
+	)
+
+	t.Run("tokens", func(t *testing.T) {
+		var (
+			alert = testSession(t, db, "alice", "phone", `{"alert": {"fcm": "invalid"}}`, now)
+			other = testSession(t, db, "bob", "phone", `{"alert": {"fcm": "invalid"}}`, now)
+			// Partial: other type(s) registration kept
+			voip = testSession(t, db, "alice", "tablet",
+				`{"alert": {"fcm": "invalid"}, "voip": {"apn": "valid", "type": "VOIP"}}`, now)
+			valid = testSession(t, db, "alice", "laptop", `{"alert": {"fcm": "valid"}}`, now)
+		)
+
+		count, err := sessions.ExpirePushTokens(store.ExpirePushTokenRequest{
+			Context: ctx,
+			Tokens: []*model.PushToken{
+				{Token: &authpb.PUSHSubscription_Fcm{Fcm: "invalid"}},
+				{Token: &authpb.PUSHSubscription_Apn{Apn: "unknown"}},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 3 {
+			t.Errorf("ExpirePushTokens( tokens ) = %d, want 3 ; whatever the session", count)
+		}
+		// NULLIF( {} ) ; the last registration removed
+		for _, id := range []string{alert, other} {
+			if token := testPushToken(t, db, id); token != nil {
+				t.Errorf("ExpirePushTokens( tokens ) session( %s ) token = %v, want NULL", id, token)
+			}
+		}
+		if token := testPushToken(t, db, voip); token[model.PushAlert] != nil || testToken(token, model.PushVoIP, "apn") != "valid" {
+			t.Errorf("ExpirePushTokens( tokens ) session( %s ) token = %v, want { voip } kept", voip, token)
+		}
+		if token := testPushToken(t, db, valid); testToken(token, model.PushAlert, "fcm") != "valid" {
+			t.Errorf("ExpirePushTokens( tokens ) session( %s ) token = %v, want kept", valid, token)
+		}
+	})
+
+	t.Run("idle", func(t *testing.T) {
+		var (
+			idleSince = now.Add(-time.Hour)
+			idle      = []string{
+				testSession(t, db, "carol", "phone", `{"alert": {"fcm": "idle-1"}}`, idleSince.Add(-time.Hour)),
+				testSession(t, db, "carol", "tablet", `{"alert": {"fcm": "idle-2"}, "voip": {"apn": "idle-2"}}`, idleSince.Add(-time.Hour)),
+				testSession(t, db, "carol", "laptop", `{"web": {"web": {"endpoint": "https://push.example.com/idle-3"}}}`, idleSince.Add(-time.Hour)),
+			}
+			active = testSession(t, db, "carol", "watch", `{"alert": {"fcm": "active"}}`, now)
+			expire = func(size int) int64 {
+				count, err := sessions.ExpirePushTokens(store.ExpirePushTokenRequest{
+					Context:   ctx,
+					IdleSince: idleSince,
+					Size:      size,
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				return count
+			}
+		)
+
+		// Session locked by the concurrent sweep
+		tx, err := db.Client().Begin(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer tx.Rollback(ctx)
+		_, err = tx.Exec(ctx, `SELECT id FROM im_account.session WHERE id = $1 FOR UPDATE`, idle[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// LIMIT @size
+		if count := expire(1); count != 1 {
+			t.Errorf("ExpirePushTokens( size: 1 ) = %d, want 1", count)
+		}
+		// SKIP LOCKED
+		if count := expire(10); count != 1 {
+			t.Errorf("ExpirePushTokens( size: 10 ) = %d, want 1 ; locked skipped", count)
+		}
+		if token := testPushToken(t, db, idle[0]); token == nil {
+			t.Errorf("ExpirePushTokens( idle ) locked session token = NULL, want skipped")
+		}
+
+		if err = tx.Rollback(ctx); err != nil {
+			t.Fatal(err)
+		}
+		if count := expire(10); count != 1 {
+			t.Errorf("ExpirePushTokens( size: 10 ) = %d, want 1 ; unlocked", count)
+		}
+		if count := expire(10); count != 0 {
+			t.Errorf("ExpirePushTokens( size: 10 ) = %d, want 0 ; done", count)
+		}
+
+		for _, id := range idle {
+			if token := testPushToken(t, db, id); token != nil {
+				t.Errorf("ExpirePushTokens( idle ) session( %s ) token = %v, want NULL", id, token)
+			}
+		}
+		if token := testPushToken(t, db, active); token == nil {
+			t.Errorf("ExpirePushTokens( idle ) active session token = NULL, want kept")
+		}
+	})
+
+	t.Run("none", func(t *testing.T) {
+		_, err := sessions.ExpirePushTokens(store.ExpirePushTokenRequest{Context: ctx})
+		if err == nil {
+			t.Errorf("ExpirePushTokens() error = nil, want bad request")
+		}
+	})
+}
+
+func TestRegisterDeviceOtherUids(t *testing.T) {
+
+	var (
+		db       = newTestDB(t)
+		sessions = postgres.NewSessionStore(db)
+		ctx      = context.Background()
+		now      = time.Now()
+		token    = &model.PushToken{Token: &authpb.PUSHSubscription_Fcm{Fcm: "device"}} // alert
+
+		current = testSession(t, db, "alice", "phone", `{"voip": {"apn": "call"}}`, now)
+		byId    = testSession(t, db, "bob", "phone", "", now)                              // other_uids: { id }
+		bySub   = testSession(t, db, "carol", "phone", "", now)                            // other_uids: { iss, sub }
+		signed  = testSession(t, db, "dave", "phone", `{"alert": {"fcm": "device"}}`, now) // NOT in other_uids
+		moved   = testSession(t, db, "alice", "tablet", `{"alert": {"fcm": "device"}, "voip": {"apn": "call"}}`, now)
+		kept    = testSession(t, db, "erin", "tablet", `{"alert": {"fcm": "other"}}`, now)
+	)
+
+	otherUids := []*model.ContactId{
+		{Id: "id-bob"},
+		{Iss: testIssuer, Sub: "carol"},
+		{Iss: "https://other.example.com", Sub: "dave"}, // issuer mismatch
+	}
+
+	err := sessions.RegisterDevice(store.RegisterDeviceRequest{
+		Context: ctx,
+		Authorization: model.Authorization{
+			Dc: 1, Id: current,
+			Device: model.Device{Id: "phone"},
+		},
+		Token:     token,
+		OtherUids: otherUids,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		name    string
+		session string
+		alert   any // { alert } token ; nil: none
+		voip    any // { voip } token ; nil: none
+		null    bool
+	}{
+		{"current", current, "device", "call", false}, // same type replaced only
+		{"other_uids/id", byId, "device", nil, false},
+		{"other_uids/iss+sub", bySub, "device", nil, false},
+		{"other_uids/missing", signed, nil, nil, true}, // moved
+		{"moved", moved, nil, "call", false},           // same type removed only
+		{"kept", kept, "other", nil, false},
+	} {
+		got := testPushToken(t, db, test.session)
+		if (got == nil) != test.null || testToken(got, model.PushAlert, "fcm") != test.alert ||
+			testToken(got, model.PushVoIP, "apn") != test.voip {
+			t.Errorf("RegisterDevice() %s session token = %v, want { alert: %v, voip: %v }", test.name, got, test.alert, test.voip)
+		}
+	}
+
+	// Unregister for the current and the { id } contact only
+	err = sessions.UnregisterDevice(store.UnregisterDeviceRequest{
+		Context:   ctx,
+		SessionId: current,
+		Token:     token,
+		OtherUids: otherUids[:1],
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		name    string
+		session string
+		alert   any // { alert } token ; nil: none
+		null    bool
+	}{
+		{"current", current, nil, false}, // { voip } kept
+		{"other_uids/id", byId, nil, true},
+		{"other_uids/missing", bySub, "device", false},
+		{"kept", kept, "other", false},
+	} {
+		got := testPushToken(t, db, test.session)
+		if (got == nil) != test.null || testToken(got, model.PushAlert, "fcm") != test.alert {
+			t.Errorf("UnregisterDevice() %s session token = %v, want { alert: %v }", test.name, got, test.alert)
+		}
+	}
+}
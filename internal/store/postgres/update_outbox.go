@@ -0,0 +1,328 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/webitel/im-account-service/infra/db/pg"
+	"github.com/webitel/im-account-service/internal/model"
+	"github.com/webitel/im-account-service/internal/store"
+	"github.com/webitel/im-account-service/internal/store/postgres/pgtypex"
+	clientpb "github.com/webitel/im-account-service/proto/gen/im/client/v1"
+)
+
+var _ store.UpdateOutboxStore = (*UpdateOutboxStore)(nil)
+
+type UpdateOutboxStore struct {
+	db *pg.DB
+}
+
+func NewUpdateOutboxStore(db *pg.DB) *UpdateOutboxStore {
+	return &UpdateOutboxStore{db: db}
+}
+
+// Update delivery record column(s) ; See [scanUpdateDelivery]
+const updateDeliveryColumns = `
+		q.dc, q.id
+	, q.app_id::text, coalesce(q.contact_id, ''), q.type, q.data
+	, q.state, q.attempts, q.next_at
+	, coalesce(q.status, 0), coalesce(q.error, '')
+	, q.created_at, q.updated_at
+`
+
+// scanUpdateDelivery decodes [updateDeliveryColumns] row.
+func scanUpdateDelivery(row pgx.Row) (*model.UpdateDelivery, error) {
+
+	var (
+		res      model.UpdateDelivery
+		next     *time.Time
+		created  *time.Time
+		updated  *time.Time
+		jsonbEnc = &protojsonCodec
+	)
+
+	err := row.Scan(
+		&res.Dc, &res.Id,
+		&res.AppId, &res.ContactId, &res.Type,
+		pgtypex.ScanBytesFunc(func(src []byte) error {
+			res.Update = &clientpb.Update{}
+			return jsonbEnc.Unmarshal(src, res.Update)
+		}),
+		&res.State, &res.Attempts,
+		pgtypex.ScanTimestamptz(&next),
+		&res.Status, &res.Error,
+		pgtypex.ScanTimestamptz(&created),
+		pgtypex.ScanTimestamptz(&updated),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if next != nil {
+		res.NextAt = *next
+	}
+	if created != nil {
+		res.CreatedAt = *created
+	}
+	if updated != nil {
+		res.UpdatedAt = *updated
+	}
+
+	return &res, nil
+}
+
+// Enqueue NEW pending record(s) due immediately.
+// Record(s) [Id] assigned in the given [list] order.
+func (c *UpdateOutboxStore) Enqueue(ctx context.Context, list []*model.UpdateDelivery) error {
+
+	if len(list) == 0 {
+		return nil
+	}
+
+	var (
+		size     = len(list)
+		dcs      = make([]int64, 0, size)
+		apps     = make([]string, 0, size)
+		contacts = make([]string, 0, size)
+		types    = make([]string, 0, size)
+		data     = make([]string, 0, size)
+		jsonbEnc = &protojsonCodec
+		date     = model.LocalTime.Now()
+	)
+
+	for _, rec := range list {
+		update, err := jsonbEnc.Marshal(rec.Update)
+		if err != nil {
+			return err
+		}
+		dcs = append(dcs, rec.Dc)
+		apps = append(apps, rec.AppId)
+		contacts = append(contacts, rec.ContactId)
+		types = append(types, rec.Type)
+		data = append(data, string(update))
+	}
+
+	query, args := `
+	WITH input AS MATERIALIZED
+	(
+		SELECT
+			nextval(pg_get_serial_sequence('im_account.update_outbox', 'id')) id
+		, t.*
+		FROM UNNEST(
+			@dc::int8[], @app_id::text[], @contact_id::text[]
+		, @type::text[], @data::text[]
+		) WITH ORDINALITY t(dc, app_id, contact_id, type, data, n)
+	)
+	, created AS
+	(
+		INSERT INTO im_account.update_outbox
+		(
+			dc, id, app_id, contact_id, type, data
+		, state, attempts, next_at, created_at
+		)
+		SELECT
+			t.dc, t.id, t.app_id::uuid, NULLIF(t.contact_id, '')
+		, t.type, t.data::jsonb
+		, 'pending', 0, @date, @date
+		FROM input t
+	)
+	SELECT t.id FROM input t ORDER BY t.n
+	`, pgx.NamedArgs{
+		"dc":         dcs,
+		"app_id":     apps,
+		"contact_id": contacts,
+		"type":       types,
+		"data":       data,
+		"date":       pgtypex.TimestamptzValue(&date),
+	}
+
+	rows, err := c.db.Client().Query(
+		ctx, query, args,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+
+	var i int
+	for ; rows.Next(); i++ {
+		rec := list[i]
+		err = rows.Scan(&rec.Id)
+		if err != nil {
+			return err
+		}
+		rec.State = model.UpdatePending
+		rec.NextAt = date
+		rec.CreatedAt = date
+	}
+
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	if i != size {
+		return fmt.Errorf("update.enqueue(): %d of %d record(s) stored", i, size)
+	}
+
+	return nil
+}
+
+// Claim the pending record(s) due at [req.Date].
+// Concurrent worker(s) skip the record(s) locked by each other.
+// Only the earliest pending record of the contact is claimed ; in order.
+func (c *UpdateOutboxStore) Claim(req store.ClaimUpdateRequest) ([]*model.UpdateDelivery, error) {
+
+	date := req.Date
+	if date.IsZero() {
+		date = model.LocalTime.Now()
+	}
+	lease := date.Add(req.Lease)
+
+	query, args := `
+	WITH claim AS
+	(
+		SELECT q.id
+		FROM im_account.update_outbox q
+		WHERE q.state = 'pending' AND q.next_at <= @date
+		AND NOT EXISTS
+		(
+			SELECT 1 FROM im_account.update_outbox p
+			WHERE p.app_id = q.app_id AND p.contact_id = q.contact_id
+			AND p.state = 'pending' AND p.id < q.id
+		)
+		ORDER BY q.next_at
+		LIMIT @size
+		FOR UPDATE SKIP LOCKED
+	)
+	UPDATE im_account.update_outbox q SET
+		attempts = q.attempts + 1
+	, next_at = @lease
+	FROM claim
+	WHERE q.id = claim.id
+	RETURNING
+	`+updateDeliveryColumns, pgx.NamedArgs{
+		"date":  pgtypex.TimestamptzValue(&date),
+		"lease": pgtypex.TimestamptzValue(&lease),
+		"size":  max(1, req.Size),
+	}
+
+	rows, err := c.db.Client().Query(
+		req.Context, query, args,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var list []*model.UpdateDelivery
+	for rows.Next() {
+		rec, err := scanUpdateDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, rec)
+	}
+
+	return list, rows.Err()
+}
+
+// Update the record(s) state, next attempt date and the last attempt result.
+func (c *UpdateOutboxStore) Update(ctx context.Context, list []*model.UpdateDelivery) error {
+
+	if len(list) == 0 {
+		return nil
+	}
+
+	var (
+		size    = len(list)
+		ids     = make([]int64, 0, size)
+		states  = make([]string, 0, size)
+		nextAt  = make([]time.Time, 0, size)
+		status  = make([]int32, 0, size)
+		details = make([]string, 0, size)
+		updated = make([]time.Time, 0, size)
+	)
+
+	for _, rec := range list {
+		date := rec.UpdatedAt
+		if date.IsZero() {
+			date = model.LocalTime.Now()
+		}
+		ids = append(ids, rec.Id)
+		states = append(states, rec.State)
+		nextAt = append(nextAt, rec.NextAt.UTC())
+		status = append(status, int32(rec.Status))
+		details = append(details, rec.Error)
+		updated = append(updated, date.UTC())
+	}
+
+	_, err := c.db.Client().Exec(
+		ctx, `
+		UPDATE im_account.update_outbox q SET
+			state = t.state
+		, next_at = t.next_at
+		, status = NULLIF(t.status, 0)
+		, error = NULLIF(t.error, '')
+		, updated_at = t.updated_at
+		FROM UNNEST(
+			@id::int8[], @state::text[], @next_at::timestamptz[]
+		, @status::int4[], @error::text[], @updated_at::timestamptz[]
+		) t(id, state, next_at, status, error, updated_at)
+		WHERE q.id = t.id
+		`, pgx.NamedArgs{
+			"id":         ids,
+			"state":      states,
+			"next_at":    nextAt,
+			"status":     status,
+			"error":      details,
+			"updated_at": updated,
+		},
+	)
+
+	return err
+}
+
+// Delete the delivered (or obsolete) record(s).
+func (c *UpdateOutboxStore) Delete(ctx context.Context, ids []int64) error {
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := c.db.Client().Exec(
+		ctx, `
+		DELETE FROM im_account.update_outbox q
+		WHERE q.id = ANY(@ids::int8[])
+		`, pgx.NamedArgs{
+			"ids": ids,
+		},
+	)
+
+	return err
+}
+
+// Purge the dead-letter record(s) failed before the given date.
+func (c *UpdateOutboxStore) Purge(ctx context.Context, before time.Time) (int64, error) {
+
+	res, err := c.db.Client().Exec(
+		ctx, `
+		DELETE FROM im_account.update_outbox q
+		WHERE q.state = 'failed' AND q.updated_at < @before
+		`, pgx.NamedArgs{
+			"before": pgtypex.TimestamptzValue(&before),
+		},
+	)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected(), nil
+}
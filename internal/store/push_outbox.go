@@ -0,0 +1,49 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/webitel/im-account-service/internal/model"
+)
+
+// PushOutboxStore of the PUSH notification delivery record(s).
+type PushOutboxStore interface {
+	// Enqueue NEW delivery record(s) ; [Id] and [NextAt] assigned.
+	Enqueue(ctx context.Context, list []*model.PushDelivery) error
+	// Claim the pending record(s) due for the delivery attempt.
+	// Claimed record(s) [attempts] incremented and leased up to [NextAt].
+	Claim(ClaimPushRequest) ([]*model.PushDelivery, error)
+	// Update the record(s) state after the delivery attempt.
+	Update(ctx context.Context, list []*model.PushDelivery) error
+	// Delete delivered (or obsolete) record(s).
+	Delete(ctx context.Context, ids []int64) error
+	// Purge the dead-letter record(s) failed before the given date.
+	Purge(ctx context.Context, before time.Time) (int64, error)
+	// Search delivery record(s) ; latest first.
+	Search(SearchPushRequest) (*model.PushDeliveryList, error)
+}
+
+type ClaimPushRequest struct {
+	context.Context
+	Date  time.Time     // now ; due at
+	Lease time.Duration // claimed record(s) lease
+	Size  int           // limit
+}
+
+// Delivery record(s) page size ; See [SearchPushRequest.Size]
+const (
+	SearchPushDefaultSize = 100
+	SearchPushMaxSize     = 1000
+)
+
+type SearchPushRequest struct {
+	context.Context
+	Dc        int64    // domain_id ; REQUIRED
+	AppId     string   // client_id ; OPTIONAL
+	SessionId string   // OPTIONAL
+	State     []string // OPTIONAL ; pending, failed
+
+	Page int // offset
+	Size int // limit, per page ; default: 100, max: 1000
+}
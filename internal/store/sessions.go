@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"time"
 
 	"github.com/webitel/im-account-service/internal/model"
 )
@@ -15,6 +16,11 @@ type SessionStore interface {
 
 	RegisterDevice(RegisterDeviceRequest) error
 	UnregisterDevice(UnregisterDeviceRequest) error
+	// ExpirePushTokens clears the session(s) device PUSH token(s).
+	// Returns the number of session(s) affected.
+	ExpirePushTokens(ExpirePushTokenRequest) (int64, error)
+	// Touch records the session(s) activity at once ; the later one wins.
+	Touch(ctx context.Context, list []*model.SessionActivity) error
 
 }
 
@@ -24,6 +30,7 @@ type ListSessionRequest struct {
 	// Filter(s)
 	Dc        int64
 	Id        string
+	Ids       []string
 	AppId     string // [X-Webitel-Client] ; App.ID
 	Token     string // [X-Webitel-Access]
 	DeviceId  string // [X-Webitel-Device] ; Sub.ID
@@ -77,4 +84,15 @@ type UnregisterDeviceRequest struct {
 	OtherUids []*model.ContactId
 }
 
-
+// ExpirePushTokenRequest filter(s) ; one of
+type ExpirePushTokenRequest struct {
+	// Context
+	context.Context
+	// Device token(s) rejected by the PUSH service as invalid.
+	// Matched by value, whatever session(s) registered.
+	Tokens []*model.PushToken
+	// Session(s) with no activity since the date.
+	IdleSince time.Time
+	// Maximum number of session(s) to affect ; IdleSince
+	Size int
+}
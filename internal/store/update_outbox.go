@@ -0,0 +1,31 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/webitel/im-account-service/internal/model"
+)
+
+// UpdateOutboxStore of the App [Update] delivery record(s).
+type UpdateOutboxStore interface {
+	// Enqueue NEW delivery record(s) ; [Id] and [NextAt] assigned.
+	Enqueue(ctx context.Context, list []*model.UpdateDelivery) error
+	// Claim the pending record(s) due for the delivery attempt.
+	// Claimed record(s) [attempts] incremented and leased up to [NextAt].
+	// Record(s) of the contact are claimed one at a time, in order.
+	Claim(ClaimUpdateRequest) ([]*model.UpdateDelivery, error)
+	// Update the record(s) state after the delivery attempt.
+	Update(ctx context.Context, list []*model.UpdateDelivery) error
+	// Delete delivered (or obsolete) record(s).
+	Delete(ctx context.Context, ids []int64) error
+	// Purge the dead-letter record(s) failed before the given date.
+	Purge(ctx context.Context, before time.Time) (int64, error)
+}
+
+type ClaimUpdateRequest struct {
+	context.Context
+	Date  time.Time     // now ; due at
+	Lease time.Duration // claimed record(s) lease
+	Size  int           // limit
+}
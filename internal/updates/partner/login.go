@@ -0,0 +1,35 @@
+package partner
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/metadata"
+
+	clientpb "github.com/webitel/im-account-service/proto/gen/im/client/v1"
+	adminpb "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+)
+
+// CheckLogin asks the [hook] partner service to approve the [req] sign-in ;
+// See [clientpb.LoginHandlerServer] contract.
+func (c *Client) CheckLogin(ctx context.Context, hook *adminpb.LoginHook, req *clientpb.LoginRequest) (*clientpb.LoginResponse, error) {
+
+	conn, err := c.conn(hook.GetGrpc())
+	if err != nil {
+		return nil, fmt.Errorf("grpc: host; %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if token := hook.GetToken(); token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, HeaderToken, token)
+	}
+
+	res, err := clientpb.NewLoginHandlerClient(conn).CheckLogin(ctx, req)
+	if err != nil {
+		return nil, Failure(err).Err
+	}
+
+	return res, nil
+}
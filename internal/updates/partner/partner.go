@@ -0,0 +1,205 @@
+// Package partner sends the App [Update](s) to the partner service
+// of the [service.send_update.grpc] subscription,
+// implementing the [clientpb.UpdateHandlerServer] contract,
+// and calls the [service.login_hook.grpc] [clientpb.LoginHandlerServer].
+package partner
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/webitel/im-account-service/internal/model"
+	"github.com/webitel/im-account-service/internal/updates"
+	clientpb "github.com/webitel/im-account-service/proto/gen/im/client/v1"
+	adminpb "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+)
+
+// Subscription [token] request metadata key, if set
+const HeaderToken = "x-webitel-event-token"
+
+// Default partner request timeout
+const DefaultTimeout = 10 * time.Second
+
+// endpoint of the partner connection ; pool key
+type endpoint struct {
+	target string // host:port
+	addr   string // pinned IP ; "" DNS resolved
+	secure bool   // TLS
+}
+
+// Client of the partner service(s).
+// Connection(s) are pooled per partner [host] and pinned [addr].
+type Client struct {
+	timeout time.Duration
+
+	mx    sync.Mutex
+	conns map[endpoint]*grpc.ClientConn
+}
+
+var _ updates.BatchClient = (*Client)(nil)
+
+// New partner(s) Client with the request [timeout] ; zero: [DefaultTimeout].
+func New(timeout time.Duration) *Client {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &Client{
+		timeout: timeout,
+		conns:   make(map[endpoint]*grpc.ClientConn),
+	}
+}
+
+// conn returns the pooled connection of the [hook] partner.
+func (c *Client) conn(hook *adminpb.GrpcServiceSubscription) (*grpc.ClientConn, error) {
+
+	target, secure, err := model.UpdateServiceTarget(hook.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	key := endpoint{target: target, addr: hook.GetAddr(), secure: secure}
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if conn, ok := c.conns[key]; ok {
+		return conn, nil
+	}
+
+	creds := insecure.NewCredentials()
+	if secure {
+		// ServerName remains of the [host] ; even if [addr] pinned
+		creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	}
+
+	dialer := &net.Dialer{Timeout: c.timeout, KeepAlive: 30 * time.Second}
+	conn, err := grpc.NewClient(
+		// NO resolver ; dial the [target] host, or pinned [addr]
+		"passthrough:///"+target,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithContextDialer(func(ctx context.Context, address string) (net.Conn, error) {
+			if key.addr != "" {
+				_, port, err := net.SplitHostPort(address)
+				if err != nil {
+					return nil, err
+				}
+				address = net.JoinHostPort(key.addr, port)
+			}
+			return dialer.DialContext(ctx, "tcp", address)
+		}),
+		grpc.WithUserAgent("im-account-service/v26.02"),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	c.conns[key] = conn
+	return conn, nil
+}
+
+// Send the [update] to the [sub] partner service.
+func (c *Client) Send(ctx context.Context, sub *adminpb.EventSubscription, update *clientpb.Update) *updates.Result {
+	return c.SendBatch(ctx, sub, []*clientpb.Update{update})[0]
+}
+
+// SendBatch sends the [list] of the Update(s) to the [sub] partner service at once.
+// Update(s) neither acknowledged nor rejected are due to retry.
+func (c *Client) SendBatch(ctx context.Context, sub *adminpb.EventSubscription, list []*clientpb.Update) []*updates.Result {
+
+	res := make([]*updates.Result, len(list))
+	failure := func(re *updates.Result) []*updates.Result {
+		for i := range res {
+			res[i] = re
+		}
+		return res
+	}
+
+	conn, err := c.conn(sub.GetGrpc())
+	if err != nil {
+		return failure(&updates.Result{Err: fmt.Errorf("grpc: host; %v", err)})
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if token := sub.GetToken(); token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, HeaderToken, token)
+	}
+
+	rsp, err := clientpb.NewUpdateHandlerClient(conn).SendUpdates(
+		ctx, &clientpb.SendUpdatesRequest{Updates: list},
+	)
+
+	if err != nil {
+		return failure(Failure(err))
+	}
+
+	for i, update := range list {
+		switch {
+		case slices.Contains(rsp.GetAck(), update.GetId()):
+			res[i] = &updates.Result{}
+		case slices.Contains(rsp.GetReject(), update.GetId()):
+			res[i] = &updates.Result{Err: fmt.Errorf("grpc: update rejected")}
+		default:
+			res[i] = &updates.Result{Retry: true, Err: fmt.Errorf("grpc: update not acknowledged")}
+		}
+	}
+
+	return res
+}
+
+// Failure returns the Result of the partner request [err] status.
+// Result [Status] is the HTTP equivalent of the gRPC code ; retry class.
+func Failure(err error) *updates.Result {
+	re := status.Convert(err)
+	res := &updates.Result{
+		Err: fmt.Errorf("grpc: (%s) %s", re.Code(), re.Message()),
+	}
+	switch re.Code() {
+	case codes.ResourceExhausted:
+		res.Retry, res.Status = true, 429
+	case codes.Unavailable:
+		res.Retry, res.Status = true, 503
+	case codes.Internal, codes.Unknown, codes.Aborted:
+		res.Retry, res.Status = true, 500
+	case codes.DeadlineExceeded, codes.Canceled:
+		res.Retry = true // network
+	case codes.Unauthenticated:
+		res.Status = 401
+	case codes.PermissionDenied:
+		res.Status = 403
+	case codes.Unimplemented:
+		res.Status = 501
+	default:
+		res.Status = 400
+	}
+	return res
+}
+
+// Close all the pooled connection(s).
+func (c *Client) Close() error {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	var errs []error
+	for key, conn := range c.conns {
+		if err := conn.Close(); err != nil {
+			errs = append(errs, err)
+		}
+		delete(c.conns, key)
+	}
+	return errors.Join(errs...)
+}
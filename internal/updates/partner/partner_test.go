@@ -0,0 +1,86 @@
+package partner_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/webitel/im-account-service/internal/updates/partner"
+	clientpb "github.com/webitel/im-account-service/proto/gen/im/client/v1"
+	adminpb "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+)
+
+type handler struct {
+	clientpb.UnimplementedUpdateHandlerServer
+	token string
+}
+
+func (h *handler) SendUpdates(ctx context.Context, req *clientpb.SendUpdatesRequest) (*clientpb.SendUpdatesResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if token := md.Get(partner.HeaderToken); len(token) != 1 || token[0] != h.token {
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+	res := &clientpb.SendUpdatesResponse{}
+	for _, update := range req.GetUpdates() {
+		switch update.GetId() {
+		case 1:
+			res.Ack = append(res.Ack, update.GetId())
+		case 2:
+			res.Reject = append(res.Reject, update.GetId())
+		} // 3: NOT acknowledged
+	}
+	return res, nil
+}
+
+func TestClientSendBatch(t *testing.T) {
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	clientpb.RegisterUpdateHandlerServer(server, &handler{token: "s3cr3t"})
+	go server.Serve(lis)
+	defer server.Stop()
+
+	// Pinned [addr] ; the host is never resolved
+	_, port, _ := net.SplitHostPort(lis.Addr().String())
+	sub := &adminpb.EventSubscription{
+		Endpoint: &adminpb.EventSubscription_Grpc{
+			Grpc: &adminpb.GrpcServiceSubscription{
+				Host: "http://partner.example.invalid:" + port,
+				Addr: "127.0.0.1",
+			},
+		},
+		Token: "s3cr3t",
+	}
+
+	client := partner.New(5 * time.Second)
+	defer client.Close()
+
+	res := client.SendBatch(context.Background(), sub, []*clientpb.Update{
+		{Id: 1}, {Id: 2}, {Id: 3},
+	})
+
+	if !res[0].Delivered() {
+		t.Errorf("SendBatch()[0] = %+v; want delivered", res[0])
+	}
+	if res[1].Delivered() || res[1].Retry {
+		t.Errorf("SendBatch()[1] = %+v; want rejected", res[1])
+	}
+	if res[2].Delivered() || !res[2].Retry {
+		t.Errorf("SendBatch()[2] = %+v; want retry", res[2])
+	}
+
+	sub.Token = "invalid"
+	re := client.Send(context.Background(), sub, &clientpb.Update{Id: 1})
+	if re.Delivered() || re.Retry || re.Status != 401 {
+		t.Errorf("Send() = %+v; want unauthenticated", re)
+	}
+}
@@ -0,0 +1,82 @@
+// Package updates delivers the App [Update] event(s)
+// to the [service.send_update] subscription endpoint.
+package updates
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	clientpb "github.com/webitel/im-account-service/proto/gen/im/client/v1"
+	adminpb "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+)
+
+// Result of the Update delivery attempt.
+type Result struct {
+	// Transient failure ; the same Update MAY be sent later.
+	Retry bool
+	// HTTP status code of the endpoint response, if any
+	Status int
+	// Endpoint [Retry-After] hint, if any ; Retry
+	RetryAfter time.Duration
+	// Error details ; nil if Delivered
+	Err error
+}
+
+// Delivered reports whether the Update is accepted by the endpoint.
+func (res *Result) Delivered() bool {
+	return res.Err == nil
+}
+
+// Error returns the delivery failure text ; empty if Delivered.
+func (res *Result) Error() string {
+	if res.Err == nil {
+		return ""
+	}
+	if res.Status > 0 {
+		return fmt.Sprintf("update: (#%d) %v", res.Status, res.Err)
+	}
+	return "update: " + res.Err.Error()
+}
+
+// Sender of the [Update] to the subscription endpoint.
+type Sender interface {
+	Send(ctx context.Context, sub *adminpb.EventSubscription, update *clientpb.Update) *Result
+}
+
+// Failure returns the [err] transport-level failure Result ; Retry.
+func Failure(err error) *Result {
+	return &Result{Retry: true, Err: err}
+}
+
+// Retryable reports whether the endpoint response HTTP [status] is a transient failure.
+func Retryable(status int) bool {
+	return status == http.StatusRequestTimeout ||
+		status == http.StatusTooManyRequests ||
+		status >= 500
+}
+
+// BatchSender of the [Update](s) batch to the subscription endpoint.
+// Result(s) are reported in the [list] order.
+type BatchSender interface {
+	Sender
+	SendBatch(ctx context.Context, sub *adminpb.EventSubscription, list []*clientpb.Update) []*Result
+}
+
+// LoginChecker asks the App [service.login_hook] endpoint to approve the [req] sign-in.
+type LoginChecker interface {
+	CheckLogin(ctx context.Context, hook *adminpb.LoginHook, req *clientpb.LoginRequest) (*clientpb.LoginResponse, error)
+}
+
+// Client of the App [service.send_update] and [service.login_hook] endpoint(s).
+type Client interface {
+	Sender
+	LoginChecker
+}
+
+// BatchClient is the [Client] of the batch [Update](s) endpoint(s).
+type BatchClient interface {
+	BatchSender
+	LoginChecker
+}
@@ -0,0 +1,100 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	clientpb "github.com/webitel/im-account-service/proto/gen/im/client/v1"
+	adminpb "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+)
+
+// [HeaderEvent] of the pre-login request
+const EventLogin = "login"
+
+// CheckLogin asks the [hook] webhook endpoint to approve the [req] sign-in.
+// Endpoint responds (2xx) with the [clientpb.LoginResponse] of the request [codec].
+func (c *Client) CheckLogin(ctx context.Context, hook *adminpb.LoginHook, req *clientpb.LoginRequest) (*clientpb.LoginResponse, error) {
+
+	web := hook.GetWeb()
+	if web.GetUrl() == "" {
+		return nil, fmt.Errorf("webhook: url required")
+	}
+
+	var (
+		body        []byte
+		err         error
+		contentType = ContentTypeJSON
+	)
+	switch web.GetCodec() {
+	case adminpb.WebhookSubscription_PROTO:
+		contentType = ContentTypeProto
+		body, err = proto.Marshal(req)
+	default:
+		body, err = protojson.Marshal(req)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	send, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, web.GetUrl(), bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	header := send.Header
+	header.Set("Content-Type", contentType)
+	header.Set("Accept", contentType)
+	header.Set(HeaderEvent, EventLogin)
+	if token := hook.GetToken(); token != "" {
+		header.Set(HeaderToken, token)
+	}
+	if secret := hook.GetSecret(); secret != "" {
+		header.Set(HeaderSignature, Sign(secret, c.now(), body))
+	}
+
+	rsp, err := c.client(web.GetAddr()).Do(send)
+	if err != nil {
+		return nil, err
+	}
+	defer rsp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(rsp.Body, 1<<16))
+	if err != nil {
+		return nil, err
+	}
+
+	if rsp.StatusCode < 200 || 300 <= rsp.StatusCode {
+		text := strings.TrimSpace(string(data))
+		if len(text) > 256 {
+			text = text[:256]
+		}
+		if text == "" {
+			text = http.StatusText(rsp.StatusCode)
+		}
+		return nil, fmt.Errorf("webhook: (#%d) %s", rsp.StatusCode, text)
+	}
+
+	res := &clientpb.LoginResponse{}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return res, nil // 204 ; ALLOW as is
+	}
+	if contentType == ContentTypeProto {
+		err = proto.Unmarshal(data, res)
+	} else {
+		err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, res)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("webhook: login response; %v", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,193 @@
+// Package webhook sends the App [Update](s) to the HTTP endpoint
+// of the [service.send_update.web] subscription
+// and calls the [service.login_hook.web] endpoint.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/webitel/im-account-service/internal/model"
+	"github.com/webitel/im-account-service/internal/push"
+	"github.com/webitel/im-account-service/internal/updates"
+	clientpb "github.com/webitel/im-account-service/proto/gen/im/client/v1"
+	adminpb "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+)
+
+// Webhook request header(s)
+const (
+	// Update.Data.(type) ; See [model.UpdateType]
+	HeaderEvent = "X-Webitel-Event"
+	// Update ID ; de-duplication
+	HeaderEventId = "X-Webitel-Event-Id"
+	// Subscription [token], if set
+	HeaderToken = "X-Webitel-Event-Token"
+	// HMAC-SHA256 signature of the request body, if the subscription [secret] set ; See [Sign]
+	HeaderSignature = "X-Webitel-Event-Signature"
+)
+
+// Update(s) content type of the [codec]
+const (
+	ContentTypeJSON  = "application/json"
+	ContentTypeProto = "application/proto"
+)
+
+// Default endpoint request timeout
+const DefaultTimeout = 10 * time.Second
+
+// Client of the webhook endpoint(s).
+// HTTP client(s) are pooled per pinned [addr].
+type Client struct {
+	timeout time.Duration
+	now     func() time.Time
+
+	mx      sync.Mutex
+	clients map[string]*http.Client // [addr] ; "" DNS resolved
+}
+
+var _ updates.Client = (*Client)(nil)
+
+// New webhook Client with the endpoint request [timeout] ; zero: [DefaultTimeout].
+func New(timeout time.Duration) *Client {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &Client{
+		timeout: timeout,
+		now:     time.Now,
+		clients: make(map[string]*http.Client),
+	}
+}
+
+// client returns the HTTP client dialing the fixed [addr] IP, if given.
+// TLS server name remains of the webhook URL host.
+func (c *Client) client(addr string) *http.Client {
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if client, ok := c.clients[addr]; ok {
+		return client
+	}
+
+	dialer := &net.Dialer{Timeout: c.timeout, KeepAlive: 30 * time.Second}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	if addr != "" {
+		transport.DialContext = func(ctx context.Context, network, address string) (net.Conn, error) {
+			_, port, err := net.SplitHostPort(address)
+			if err != nil {
+				return nil, err
+			}
+			return dialer.DialContext(ctx, network, net.JoinHostPort(addr, port))
+		}
+	}
+
+	client := &http.Client{
+		Transport: transport,
+		Timeout:   c.timeout,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			// Endpoint MUST accept the update itself
+			return http.ErrUseLastResponse
+		},
+	}
+	c.clients[addr] = client
+	return client
+}
+
+// Send the [update] to the [sub] webhook endpoint.
+func (c *Client) Send(ctx context.Context, sub *adminpb.EventSubscription, update *clientpb.Update) *updates.Result {
+
+	hook := sub.GetWeb()
+	if hook.GetUrl() == "" {
+		return &updates.Result{Err: fmt.Errorf("webhook: url required")}
+	}
+
+	var (
+		body        []byte
+		err         error
+		contentType = ContentTypeJSON
+	)
+	switch hook.GetCodec() {
+	case adminpb.WebhookSubscription_PROTO:
+		contentType = ContentTypeProto
+		body, err = proto.Marshal(update)
+	default:
+		body, err = protojson.Marshal(update)
+	}
+	if err != nil {
+		return &updates.Result{Err: err}
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, hook.GetUrl(), bytes.NewReader(body),
+	)
+	if err != nil {
+		return &updates.Result{Err: err}
+	}
+
+	header := req.Header
+	header.Set("Content-Type", contentType)
+	header.Set(HeaderEvent, model.UpdateType(update))
+	header.Set(HeaderEventId, strconv.FormatInt(update.GetId(), 10))
+	if token := sub.GetToken(); token != "" {
+		header.Set(HeaderToken, token)
+	}
+	if secret := sub.GetSecret(); secret != "" {
+		header.Set(HeaderSignature, Sign(secret, c.now(), body))
+	}
+
+	rsp, err := c.client(hook.GetAddr()).Do(req)
+	if err != nil {
+		return updates.Failure(err)
+	}
+	defer rsp.Body.Close()
+
+	data, _ := io.ReadAll(io.LimitReader(rsp.Body, 1<<12))
+	if 200 <= rsp.StatusCode && rsp.StatusCode < 300 {
+		return &updates.Result{Status: rsp.StatusCode}
+	}
+
+	text := strings.TrimSpace(string(data))
+	if len(text) > 256 {
+		text = text[:256]
+	}
+	if text == "" {
+		text = http.StatusText(rsp.StatusCode)
+	}
+
+	return &updates.Result{
+		Retry:      updates.Retryable(rsp.StatusCode),
+		Status:     rsp.StatusCode,
+		RetryAfter: push.RetryAfter(rsp.Header, c.now()),
+		Err:        fmt.Errorf("webhook: %s", text),
+	}
+}
+
+// Sign returns the [HeaderSignature] value of the request [body]:
+// t=<unix>,v1=<hex> ; HMAC-SHA256 of "<unix>.<body>" keyed with the subscription [secret].
+// The [secret] is never transmitted, unlike the [HeaderToken].
+// Endpoint SHOULD reject the stale [t] timestamp to prevent replay.
+func Sign(secret string, date time.Time, body []byte) string {
+	unix := strconv.FormatInt(date.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unix))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return "t=" + unix + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,156 @@
+package webhook_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/webitel/im-account-service/internal/updates/webhook"
+	clientpb "github.com/webitel/im-account-service/proto/gen/im/client/v1"
+	adminpb "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+)
+
+func TestClientSend(t *testing.T) {
+
+	var status int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if got := r.Header.Get("Content-Type"); got != webhook.ContentTypeJSON {
+			t.Errorf("Content-Type = %q, want %q", got, webhook.ContentTypeJSON)
+		}
+		if got := r.Header.Get(webhook.HeaderEvent); got != "sign_in" {
+			t.Errorf("%s = %q, want sign_in", webhook.HeaderEvent, got)
+		}
+		if got := r.Header.Get(webhook.HeaderEventId); got != "42" {
+			t.Errorf("%s = %q, want 42", webhook.HeaderEventId, got)
+		}
+		if got := r.Header.Get(webhook.HeaderToken); got != "s3cr3t" {
+			t.Errorf("%s = %q, want s3cr3t", webhook.HeaderToken, got)
+		}
+		// Signed at the (unknown) send date ; verify by the [t] given
+		signature := r.Header.Get(webhook.HeaderSignature)
+		var unix int64
+		if _, err := fmt.Sscanf(signature, "t=%d,", &unix); err != nil {
+			t.Errorf("%s = %q; %v", webhook.HeaderSignature, signature, err)
+		} else if want := webhook.Sign("signing-key-0123456789", time.Unix(unix, 0), body); signature != want {
+			t.Errorf("%s = %q, want %q", webhook.HeaderSignature, signature, want)
+		}
+		var update clientpb.Update
+		if err := protojson.Unmarshal(body, &update); err != nil || update.GetSignIn().GetSession().GetId() != "s1" {
+			t.Errorf("body = %s; %v", body, err)
+		}
+		if status == http.StatusServiceUnavailable {
+			w.Header().Set("Retry-After", "30")
+		}
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	// Pinned [addr] ; the URL host is never resolved
+	_, port, _ := net.SplitHostPort(server.Listener.Addr().String())
+	sub := &adminpb.EventSubscription{
+		Endpoint: &adminpb.EventSubscription_Web{
+			Web: &adminpb.WebhookSubscription{
+				Url:  "http://hooks.example.invalid:" + port + "/im/updates",
+				Addr: "127.0.0.1",
+			},
+		},
+		Token:  "s3cr3t",
+		Secret: "signing-key-0123456789",
+	}
+
+	update := &clientpb.Update{
+		Id: 42, Dc: 1, AppId: "app",
+		Data: &clientpb.Update_SignIn{
+			SignIn: &clientpb.SignIn{Session: &clientpb.Session{Id: "s1"}},
+		},
+	}
+
+	client := webhook.New(5 * time.Second)
+	for _, test := range []struct {
+		status    int
+		delivered bool
+		retry     bool
+	}{
+		{http.StatusNoContent, true, false},
+		{http.StatusServiceUnavailable, false, true},
+		{http.StatusBadRequest, false, false},
+	} {
+		status = test.status
+		res := client.Send(context.Background(), sub, update)
+		if res.Delivered() != test.delivered || res.Retry != test.retry || res.Status != test.status {
+			t.Errorf("Send() (#%d) = %+v; want delivered: %t, retry: %t", test.status, res, test.delivered, test.retry)
+		}
+		if test.status == http.StatusServiceUnavailable && res.RetryAfter != 30*time.Second {
+			t.Errorf("Send() (#%d) RetryAfter = %s, want 30s", test.status, res.RetryAfter)
+		}
+	}
+}
+
+func TestClientCheckLogin(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get(webhook.HeaderEvent); got != webhook.EventLogin {
+			t.Errorf("%s = %q, want %s", webhook.HeaderEvent, got, webhook.EventLogin)
+		}
+		// Unsigned ; NO [secret] set
+		if got := r.Header.Get(webhook.HeaderSignature); got != "" {
+			t.Errorf("%s = %q, want none", webhook.HeaderSignature, got)
+		}
+		if got := r.Header.Get(webhook.HeaderToken); got != "s3cr3t" {
+			t.Errorf("%s = %q, want s3cr3t", webhook.HeaderToken, got)
+		}
+		body, _ := io.ReadAll(r.Body)
+		var req clientpb.LoginRequest
+		if err := protojson.Unmarshal(body, &req); err != nil {
+			t.Errorf("LoginRequest: %v", err)
+		}
+		switch req.GetContact().GetSub() {
+		case "deny":
+			w.Header().Set("Content-Type", webhook.ContentTypeJSON)
+			fmt.Fprint(w, `{"action":"DENY","message":"blocked","unknown":true}`)
+		case "patch":
+			w.Header().Set("Content-Type", webhook.ContentTypeJSON)
+			fmt.Fprint(w, `{"contact":{"sub":"crm-1","metadata":{"tier":"gold"}}}`)
+		case "allow":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.Error(w, "boom", http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	hook := &adminpb.LoginHook{
+		Endpoint: &adminpb.LoginHook_Web{
+			Web: &adminpb.WebhookSubscription{Url: server.URL + "/im/login"},
+		},
+		Token: "s3cr3t",
+	}
+
+	client := webhook.New(5 * time.Second)
+	check := func(sub string) (*clientpb.LoginResponse, error) {
+		return client.CheckLogin(context.Background(), hook, &clientpb.LoginRequest{
+			Contact: &clientpb.Contact{Iss: "https://idp.example.com", Sub: sub},
+		})
+	}
+
+	if res, err := check("allow"); err != nil || res.GetAction() != clientpb.LoginResponse_ALLOW {
+		t.Errorf("CheckLogin(allow) = %v, %v; want ALLOW", res, err)
+	}
+	if res, err := check("deny"); err != nil || res.GetAction() != clientpb.LoginResponse_DENY || res.GetMessage() != "blocked" {
+		t.Errorf("CheckLogin(deny) = %v, %v; want DENY", res, err)
+	}
+	if res, err := check("patch"); err != nil || res.GetContact().GetSub() != "crm-1" || res.GetContact().GetMetadata()["tier"] != "gold" {
+		t.Errorf("CheckLogin(patch) = %v, %v; want contact patch", res, err)
+	}
+	if _, err := check("fail"); err == nil {
+		t.Errorf("CheckLogin(fail) = nil error; want (#500)")
+	}
+}
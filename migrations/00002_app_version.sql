@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+--------------------------------------------------------------------------------
+
+ALTER TABLE im_account.app
+  ADD COLUMN ver int4 DEFAULT 1 NOT NULL -- Configuration version
+;
+
+COMMENT ON COLUMN im_account.app.ver IS 'Configuration version ; optimistic concurrency control';
+
+--------------------------------------------------------------------------------
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE im_account.app DROP COLUMN ver ;
+
+-- +goose StatementEnd
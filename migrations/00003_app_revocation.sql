@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+--------------------------------------------------------------------------------
+
+-- DELETE App ; CASCADE all its session(s)
+
+ALTER TABLE im_account.session
+  DROP CONSTRAINT session_app_fk
+, ADD CONSTRAINT session_app_fk FOREIGN KEY (dc, app_id) REFERENCES im_account.app(dc, id) ON DELETE CASCADE
+;
+
+CREATE INDEX session_app_id ON im_account.session (app_id) ;
+
+COMMENT ON COLUMN im_account.app.revoked_at IS 'Revocation date ; config.block';
+
+--------------------------------------------------------------------------------
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX im_account.session_app_id ;
+
+ALTER TABLE im_account.session
+  DROP CONSTRAINT session_app_fk
+, ADD CONSTRAINT session_app_fk FOREIGN KEY (dc, app_id) REFERENCES im_account.app(dc, id)
+;
+
+-- +goose StatementEnd
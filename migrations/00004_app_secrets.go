@@ -0,0 +1,140 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/webitel/im-account-service/infra/secrets"
+	"github.com/webitel/im-account-service/internal/model"
+	v1 "github.com/webitel/im-account-service/proto/gen/im/service/admin/v1"
+)
+
+// appSecrets migration adds the app config secret(s) envelope column.
+// Down decrypts the sealed secret(s) back into the app config with the [keys].
+func appSecrets(keys *secrets.Keyring) *goose.Migration {
+	return goose.NewGoMigration(4,
+		&goose.GoFunc{
+			RunTx: func(ctx context.Context, tx *sql.Tx) error {
+				_, err := tx.ExecContext(ctx, `
+				ALTER TABLE im_account.app
+				  ADD COLUMN secrets jsonb NULL -- Encrypted config secret(s) envelope
+				;
+
+				COMMENT ON COLUMN im_account.app.secrets IS 'Config secret(s) envelope ; { kid, key, data{path:ciphertext} }';
+				`)
+				return err
+			},
+		},
+		&goose.GoFunc{
+			RunTx: func(ctx context.Context, tx *sql.Tx) error {
+				err := openAppSecrets(ctx, tx, keys)
+				if err != nil {
+					return err
+				}
+				_, err = tx.ExecContext(ctx, `
+				ALTER TABLE im_account.app DROP COLUMN secrets ;
+				`)
+				return err
+			},
+		},
+	)
+}
+
+// openAppSecrets restores the sealed secret(s) of ALL the app(s) as the config plaintext.
+// Fails if any sealed and the [keys] are not configured ; secrets would be lost (!)
+func openAppSecrets(ctx context.Context, tx *sql.Tx, keys *secrets.Keyring) error {
+
+	rows, err := tx.QueryContext(ctx, `
+	SELECT dc, id, config, secrets
+	FROM im_account.app
+	WHERE secrets NOTNULL
+	FOR UPDATE
+	`)
+
+	if err != nil {
+		return err
+	}
+
+	type sealedApp struct {
+		dc     int64
+		id     string
+		config []byte
+	}
+
+	var list []sealedApp
+
+	for rows.Next() {
+
+		var (
+			row    sealedApp
+			src    []byte
+			sealed secrets.Envelope
+		)
+
+		err = rows.Scan(&row.dc, &row.id, &row.config, &src)
+		if err != nil {
+			break
+		}
+
+		if keys == nil {
+			err = fmt.Errorf("app( %s ); secrets: keyring not configured", row.id)
+			break
+		}
+
+		err = json.Unmarshal(src, &sealed)
+		if err != nil {
+			err = fmt.Errorf("app( %s ); secrets: %v", row.id, err)
+			break
+		}
+
+		var data map[string][]byte
+		data, err = keys.Open([]byte(row.id), &sealed)
+		if err != nil {
+			err = fmt.Errorf("app( %s ); %v", row.id, err)
+			break
+		}
+
+		app := &v1.Application{}
+		err = protojson.UnmarshalOptions{AllowPartial: true}.Unmarshal(row.config, app)
+		if err != nil {
+			err = fmt.Errorf("app( %s ); config: %v", row.id, err)
+			break
+		}
+
+		model.SetAppSecrets(app, data)
+		row.config, err = protojson.MarshalOptions{AllowPartial: true, UseProtoNames: true}.Marshal(app)
+		if err != nil {
+			err = fmt.Errorf("app( %s ); config: %v", row.id, err)
+			break
+		}
+
+		list = append(list, row)
+	}
+
+	if err == nil {
+		err = rows.Err()
+	}
+	rows.Close()
+
+	if err != nil {
+		return err
+	}
+
+	for _, row := range list {
+		_, err = tx.ExecContext(ctx, `
+		UPDATE im_account.app SET config = $3, secrets = NULL
+		WHERE dc = $1 AND id = $2
+		`, row.dc, row.id, row.config)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+--------------------------------------------------------------------------------
+
+-- im_account.app_secret DEFINITION
+
+-- DROP TABLE im_account.app_secret ;
+
+CREATE TABLE im_account.app_secret
+(
+  dc int8 NOT NULL -- Business Account ID
+, app_id uuid NOT NULL -- Application ID [client_id]
+, id uuid DEFAULT gen_random_uuid() NOT NULL -- Secret ID
+
+, hash bytea NOT NULL -- SHA-256 of the [client_secret]
+, hint text NULL -- Last few char(s) of the [client_secret]
+
+, created_at timestamptz DEFAULT timezone('utc', NOW()) NOT NULL
+, expires_at timestamptz NULL -- Overlap window end ; on rotation
+, last_used_at timestamptz NULL
+, revoked_at timestamptz NULL
+
+, CONSTRAINT app_secret_id PRIMARY KEY (id)
+, CONSTRAINT app_secret_hash UNIQUE (app_id, hash)
+, CONSTRAINT app_secret_app_fk FOREIGN KEY (dc, app_id) REFERENCES im_account.app(dc, id) ON DELETE CASCADE
+);
+
+COMMENT ON TABLE im_account.app_secret IS 'Application [client_secret] credential(s)';
+
+COMMENT ON COLUMN im_account.app_secret.hash IS 'SHA-256 of the [client_secret] ; plaintext is never stored';
+COMMENT ON COLUMN im_account.app_secret.hint IS 'Last few char(s) of the [client_secret] ; OPTIONAL';
+COMMENT ON COLUMN im_account.app_secret.expires_at IS 'Expiration date ; end of the rotation overlap window ; OPTIONAL';
+COMMENT ON COLUMN im_account.app_secret.last_used_at IS 'Last successful use date ; OPTIONAL';
+COMMENT ON COLUMN im_account.app_secret.revoked_at IS 'Revocation date ; OPTIONAL';
+
+--------------------------------------------------------------------------------
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE im_account.app_secret ;
+
+-- +goose StatementEnd
@@ -0,0 +1,60 @@
+-- +goose Up
+-- +goose StatementBegin
+--------------------------------------------------------------------------------
+
+-- im_account.app_history DEFINITION
+
+-- DROP TABLE im_account.app_history ;
+
+CREATE TABLE im_account.app_history
+(
+  dc int8 NOT NULL -- Business Account ID
+, app_id uuid NOT NULL -- Application ID [client_id]
+, ver int4 NOT NULL -- Configuration version
+
+, op name NOT NULL -- create, update, restore, revoke
+, diff text[] NULL -- Field path(s) changed since the previous version
+, restored int4 NULL -- Version restored from ; op: restore
+
+, "name" text NOT NULL
+, about text NULL
+, config jsonb NULL
+, secrets jsonb NULL
+, revoked_at timestamptz NULL
+
+, changed_at timestamptz DEFAULT timezone('utc', NOW()) NOT NULL
+, changed_by text NULL -- Author [user.id]
+, changed_by_name text NULL -- Author [user.name]
+
+, CONSTRAINT app_history_id PRIMARY KEY (app_id, ver)
+, CONSTRAINT app_history_app_fk FOREIGN KEY (dc, app_id) REFERENCES im_account.app(dc, id) ON DELETE CASCADE
+);
+
+COMMENT ON TABLE im_account.app_history IS 'Application configuration version(s) snapshot';
+
+COMMENT ON COLUMN im_account.app_history.op IS 'Change operation: create, update, restore, revoke';
+COMMENT ON COLUMN im_account.app_history.diff IS 'Field path(s) changed since the previous version ; values are never included';
+COMMENT ON COLUMN im_account.app_history.restored IS 'Version restored from ; op: restore';
+COMMENT ON COLUMN im_account.app_history.secrets IS 'Config secret(s) envelope ; See im_account.app.secrets';
+
+-- Initial snapshot of the existing App(s)
+INSERT INTO im_account.app_history
+(
+  dc, app_id, ver, op, "name", about, config, secrets, revoked_at, changed_at
+)
+SELECT
+  app.dc, app.id, app.ver, 'create', app."name", app.about, app.config, app.secrets, app.revoked_at
+, coalesce(app.updated_at, app.created_at)
+FROM im_account.app app
+;
+
+--------------------------------------------------------------------------------
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE im_account.app_history ;
+
+-- +goose StatementEnd
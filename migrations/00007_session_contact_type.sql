@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+--------------------------------------------------------------------------------
+
+-- im_account.contact_id DEFINITION
+-- Composite text format of the im_account.session.contact_id column.
+-- Used to lookup session(s) by the contact ID component(s).
+
+-- DROP TYPE im_account.contact_id ;
+
+CREATE TYPE im_account.contact_id AS
+(
+  dc int8 -- Business Account ID
+, id text -- Contact Internal ID
+, iss text -- Issuer identifier
+, sub text -- Subject identifier, under Issuer
+);
+
+COMMENT ON TYPE im_account.contact_id IS 'Signed-In (Account) ID components';
+
+--------------------------------------------------------------------------------
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TYPE im_account.contact_id ;
+
+-- +goose StatementEnd
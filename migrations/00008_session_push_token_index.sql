@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+--------------------------------------------------------------------------------
+
+-- Lookup session(s) by the device PUSH token value ; @> containment
+
+CREATE INDEX session_push_token ON im_account.session
+USING gin (push_token jsonb_path_ops)
+WHERE push_token NOTNULL ;
+
+--------------------------------------------------------------------------------
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX im_account.session_push_token ;
+
+-- +goose StatementEnd
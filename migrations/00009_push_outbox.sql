@@ -0,0 +1,58 @@
+-- +goose Up
+-- +goose StatementBegin
+--------------------------------------------------------------------------------
+
+-- im_account.push_outbox DEFINITION
+
+-- DROP TABLE im_account.push_outbox ;
+
+CREATE TABLE im_account.push_outbox
+(
+  dc int8 NOT NULL -- Business Account ID
+, id int8 GENERATED BY DEFAULT AS IDENTITY NOT NULL -- Delivery ID
+
+, session_id uuid NOT NULL -- Recipient session ID
+, app_id uuid NULL -- Session App [client_id]
+, provider name NOT NULL -- PUSH service: fcm, apn, web
+, push_token jsonb NOT NULL -- Device PUSH token ; snapshot
+, message jsonb NOT NULL -- Notification
+
+, state name DEFAULT 'pending' NOT NULL -- pending, failed
+, attempts int4 DEFAULT 0 NOT NULL -- Delivery attempt(s) made
+, next_at timestamptz DEFAULT timezone('utc', NOW()) NOT NULL -- Next attempt date ; claim lease end
+
+, status int4 NULL -- Last attempt HTTP status code
+, reason text NULL -- Last attempt error reason code
+, error text NULL -- Last attempt error details
+
+, created_at timestamptz DEFAULT timezone('utc', NOW()) NOT NULL
+, updated_at timestamptz NULL -- Last attempt date
+
+, CONSTRAINT push_outbox_id PRIMARY KEY (id)
+, CONSTRAINT push_outbox_session_fk FOREIGN KEY (session_id) REFERENCES im_account.session(id) ON DELETE CASCADE
+);
+
+COMMENT ON TABLE im_account.push_outbox IS 'PUSH notification delivery outbox ; pending and dead-letter(s)';
+
+COMMENT ON COLUMN im_account.push_outbox.push_token IS 'Device PUSH token registered at the enqueue time';
+COMMENT ON COLUMN im_account.push_outbox.state IS 'Delivery state: pending, failed (dead-letter) ; delivered removed';
+COMMENT ON COLUMN im_account.push_outbox.next_at IS 'Next attempt date ; claimed record lease end';
+
+-- Worker(s) claim ; FOR UPDATE SKIP LOCKED
+CREATE INDEX push_outbox_pending ON im_account.push_outbox (next_at)
+WHERE state = 'pending' ;
+
+-- Support lookup
+CREATE INDEX push_outbox_app ON im_account.push_outbox (dc, app_id, id DESC) ;
+CREATE INDEX push_outbox_session ON im_account.push_outbox (session_id) ;
+
+--------------------------------------------------------------------------------
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE im_account.push_outbox ;
+
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+--------------------------------------------------------------------------------
+
+-- Device PUSH token registration(s) ; one per type: {"alert": {..}, "voip": {..}, "web": {..}}
+
+UPDATE im_account.session SET
+  push_token = jsonb_build_object(
+    CASE WHEN push_token ? 'web' THEN 'web' ELSE 'alert' END
+  , push_token
+  )
+WHERE push_token NOTNULL ;
+
+COMMENT ON COLUMN im_account.session.push_token IS 'Device PUSH token registration(s) ; {"<type>": PUSHSubscription} ; type: alert, voip, web';
+
+--------------------------------------------------------------------------------
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+-- Keep the primary registration only: alert, web or voip
+UPDATE im_account.session SET
+  push_token = coalesce(push_token -> 'alert', push_token -> 'web', push_token -> 'voip')
+WHERE push_token NOTNULL ;
+
+COMMENT ON COLUMN im_account.session.push_token IS NULL;
+
+-- +goose StatementEnd
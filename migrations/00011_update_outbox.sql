@@ -0,0 +1,53 @@
+-- +goose Up
+-- +goose StatementBegin
+--------------------------------------------------------------------------------
+
+-- im_account.update_outbox DEFINITION
+
+-- DROP TABLE im_account.update_outbox ;
+
+CREATE TABLE im_account.update_outbox
+(
+  dc int8 NOT NULL -- Business Account ID
+, id int8 GENERATED BY DEFAULT AS IDENTITY NOT NULL -- Update ID
+
+, app_id uuid NOT NULL -- Subscribed App [client_id]
+, contact_id text NULL -- Related contact ID
+, type name NOT NULL -- Update.Data.(type)
+, data jsonb NOT NULL -- Update
+
+, state name DEFAULT 'pending' NOT NULL -- pending, failed
+, attempts int4 DEFAULT 0 NOT NULL -- Delivery attempt(s) made
+, next_at timestamptz DEFAULT timezone('utc', NOW()) NOT NULL -- Next attempt date ; claim lease end
+
+, status int4 NULL -- Last attempt HTTP status code
+, error text NULL -- Last attempt error details
+
+, created_at timestamptz DEFAULT timezone('utc', NOW()) NOT NULL
+, updated_at timestamptz NULL -- Last attempt date
+
+, CONSTRAINT update_outbox_id PRIMARY KEY (id)
+);
+
+COMMENT ON TABLE im_account.update_outbox IS 'App [send_update] subscription delivery outbox ; pending and dead-letter(s)';
+
+COMMENT ON COLUMN im_account.update_outbox.state IS 'Delivery state: pending, failed (dead-letter) ; delivered removed';
+COMMENT ON COLUMN im_account.update_outbox.next_at IS 'Next attempt date ; claimed record lease end';
+
+-- Worker(s) claim ; FOR UPDATE SKIP LOCKED
+CREATE INDEX update_outbox_pending ON im_account.update_outbox (next_at)
+WHERE state = 'pending' ;
+
+-- Support lookup
+CREATE INDEX update_outbox_app ON im_account.update_outbox (dc, app_id, id DESC) ;
+
+--------------------------------------------------------------------------------
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE im_account.update_outbox ;
+
+-- +goose StatementEnd
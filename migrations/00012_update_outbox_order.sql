@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+--------------------------------------------------------------------------------
+
+-- Per contact delivery order ; the earlier pending Update blocks the later one(s)
+CREATE INDEX update_outbox_contact ON im_account.update_outbox (app_id, contact_id, id)
+WHERE state = 'pending' AND contact_id IS NOT NULL ;
+
+--------------------------------------------------------------------------------
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX im_account.update_outbox_contact ;
+
+-- +goose StatementEnd
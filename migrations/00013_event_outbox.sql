@@ -0,0 +1,126 @@
+-- +goose Up
+-- +goose StatementBegin
+--------------------------------------------------------------------------------
+
+-- im_account.event_outbox DEFINITION
+
+-- DROP TABLE im_account.event_outbox ;
+
+CREATE TABLE im_account.event_outbox
+(
+  id int8 GENERATED BY DEFAULT AS IDENTITY NOT NULL -- Event ID ; publish order
+, dc int8 NOT NULL -- Business Account ID
+, type name NOT NULL -- Event.Data.(type)
+
+, session_id uuid NOT NULL -- Session ID ; NOT a reference, may be deleted
+, app_id uuid NULL -- Session App [client_id]
+, contact_id text NULL -- Event contact ; (dc,id,iss,sub)
+, data jsonb NOT NULL -- Session snapshot and event details
+
+, attempts int4 DEFAULT 0 NOT NULL -- Publish attempt(s) made
+, next_at timestamptz DEFAULT timezone('utc', NOW()) NOT NULL -- Next attempt date ; claim lease end
+, created_at timestamptz DEFAULT timezone('utc', NOW()) NOT NULL -- Event date
+
+, CONSTRAINT event_outbox_id PRIMARY KEY (id)
+);
+
+COMMENT ON TABLE im_account.event_outbox IS 'Account domain event(s) to be published to the broker ; published removed';
+
+COMMENT ON COLUMN im_account.event_outbox.data IS 'Session {name,ip,device_id,user_agent} snapshot ; {push_type,push} registration ; {reason}';
+COMMENT ON COLUMN im_account.event_outbox.next_at IS 'Next attempt date ; claimed record lease end';
+
+-- Relay claim ; FOR UPDATE SKIP LOCKED
+CREATE INDEX event_outbox_pending ON im_account.event_outbox (next_at, id) ;
+
+-- im_account.session_event() DEFINITION
+
+-- DROP FUNCTION im_account.session_event() ;
+
+-- Records the account domain event(s) of the session change
+-- within the same transaction ; See [im_account.event_outbox].
+CREATE OR REPLACE FUNCTION im_account.session_event()
+RETURNS trigger
+LANGUAGE plpgsql
+AS $function$
+DECLARE
+  typeOf text ;
+  push_old jsonb ;
+  push_new jsonb ;
+BEGIN
+
+  IF TG_OP = 'INSERT' THEN
+    INSERT INTO im_account.event_outbox (dc, type, session_id, app_id, contact_id, data)
+    VALUES (NEW.dc, 'sign_in', NEW.id, NEW.app_id, NEW.contact_id, im_account.session_event_data(NEW, NULL)) ;
+    RETURN NULL ;
+  END IF ;
+
+  IF TG_OP = 'DELETE' THEN
+    INSERT INTO im_account.event_outbox (dc, type, session_id, app_id, contact_id, data)
+    VALUES (OLD.dc, 'sign_out', OLD.id, OLD.app_id, OLD.contact_id, im_account.session_event_data(OLD, NULL)) ;
+    RETURN NULL ;
+  END IF ;
+
+  -- UPDATE
+  IF NEW.contact_id IS DISTINCT FROM OLD.contact_id THEN
+    INSERT INTO im_account.event_outbox (dc, type, session_id, app_id, contact_id, data)
+    VALUES
+      (OLD.dc, 'session_revoked', OLD.id, OLD.app_id, OLD.contact_id, im_account.session_event_data(NEW, jsonb_build_object('reason', 'sign_in')))
+    , (NEW.dc, 'sign_in', NEW.id, NEW.app_id, NEW.contact_id, im_account.session_event_data(NEW, NULL)) ;
+  ELSIF (NEW.name, NEW.ip, NEW.user_agent) IS DISTINCT FROM (OLD.name, OLD.ip, OLD.user_agent) THEN
+    INSERT INTO im_account.event_outbox (dc, type, session_id, app_id, contact_id, data)
+    VALUES (NEW.dc, 'session_updated', NEW.id, NEW.app_id, NEW.contact_id, im_account.session_event_data(NEW, NULL)) ;
+  END IF ;
+
+  IF NEW.push_token IS DISTINCT FROM OLD.push_token THEN
+    FOREACH typeOf IN ARRAY ARRAY['alert', 'voip', 'web'] LOOP
+      push_old := OLD.push_token -> typeOf ;
+      push_new := NEW.push_token -> typeOf ;
+      CONTINUE WHEN push_new IS NOT DISTINCT FROM push_old ;
+      INSERT INTO im_account.event_outbox (dc, type, session_id, app_id, contact_id, data)
+      VALUES (
+        NEW.dc
+      , CASE WHEN push_new ISNULL THEN 'push_unregistered' ELSE 'push_registered' END
+      , NEW.id, NEW.app_id, NEW.contact_id
+      , im_account.session_event_data(NEW, jsonb_build_object(
+          'push_type', typeOf, 'push', coalesce(push_new, push_old)
+        ))
+      ) ;
+    END LOOP ;
+  END IF ;
+
+  RETURN NULL ;
+
+END
+$function$ ;
+
+-- Session [src] snapshot of the event [data]
+CREATE OR REPLACE FUNCTION im_account.session_event_data(src im_account.session, data jsonb)
+RETURNS jsonb
+LANGUAGE sql
+IMMUTABLE
+AS $function$
+  SELECT jsonb_strip_nulls(jsonb_build_object(
+    'name', src.name
+  , 'ip', host(src.ip)
+  , 'device_id', src.device_id
+  , 'user_agent', src.user_agent
+  )) || coalesce(data, '{}'::jsonb)
+$function$ ;
+
+CREATE TRIGGER session_event
+AFTER INSERT OR UPDATE OR DELETE ON im_account.session
+FOR EACH ROW EXECUTE FUNCTION im_account.session_event() ;
+
+--------------------------------------------------------------------------------
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TRIGGER session_event ON im_account.session ;
+DROP FUNCTION im_account.session_event() ;
+DROP FUNCTION im_account.session_event_data(im_account.session, jsonb) ;
+DROP TABLE im_account.event_outbox ;
+
+-- +goose StatementEnd
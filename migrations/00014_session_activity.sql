@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+--------------------------------------------------------------------------------
+
+-- Session activity ; recorded at most once per interval, see [sessions.activity_interval]
+
+ALTER TABLE im_account.session
+  ADD COLUMN last_active_at timestamptz NULL
+, ADD COLUMN last_ip inet NULL
+, ADD COLUMN ip_history inet[] NULL
+;
+
+COMMENT ON COLUMN im_account.session.last_active_at IS 'Last activity date ; authorized access seen';
+COMMENT ON COLUMN im_account.session.last_ip IS 'Last activity [FROM] IP address';
+COMMENT ON COLUMN im_account.session.ip_history IS 'Activity [FROM] IP address(es) ; most recent first, capped';
+
+-- Activity record(s) do NOT emit the account event(s)
+
+DROP TRIGGER session_event ON im_account.session ;
+
+CREATE TRIGGER session_event
+AFTER INSERT OR DELETE OR UPDATE OF contact_id, "name", ip, user_agent, push_token ON im_account.session
+FOR EACH ROW EXECUTE FUNCTION im_account.session_event() ;
+
+--------------------------------------------------------------------------------
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TRIGGER session_event ON im_account.session ;
+
+CREATE TRIGGER session_event
+AFTER INSERT OR UPDATE OR DELETE ON im_account.session
+FOR EACH ROW EXECUTE FUNCTION im_account.session_event() ;
+
+ALTER TABLE im_account.session
+  DROP COLUMN last_active_at
+, DROP COLUMN last_ip
+, DROP COLUMN ip_history
+;
+
+-- +goose StatementEnd
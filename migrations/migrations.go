@@ -1,6 +1,20 @@
 package migrations
 
-import "embed"
+import (
+	"embed"
+
+	"github.com/pressly/goose/v3"
+
+	"github.com/webitel/im-account-service/infra/secrets"
+)
 
 //go:embed *.sql
 var EmbedMigrations embed.FS
+
+// GoMigrations returns the Go migration(s), complementary to the [EmbedMigrations].
+// The app secrets [keys] are OPTIONAL ; required to revert the sealed secret(s).
+func GoMigrations(keys *secrets.Keyring) []*goose.Migration {
+	return []*goose.Migration{
+		appSecrets(keys),
+	}
+}
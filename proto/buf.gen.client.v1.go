@@ -0,0 +1,3 @@
+package buf
+
+//go:generate go run github.com/bufbuild/buf/cmd/buf@v1.63.0 generate --debug --template buf.gen.client.v1.yaml --path ../../protos/im/internal/client/v1
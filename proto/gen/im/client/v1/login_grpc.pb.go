@@ -0,0 +1,135 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: internal/client/v1/login.proto
+
+package clientpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	LoginHandler_CheckLogin_FullMethodName = "/webitel.im.internal.client.v1.LoginHandler/CheckLogin"
+)
+
+// LoginHandlerClient is the client API for LoginHandler service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// LoginHandler is implemented by the App backend
+// of the [service.login_hook.grpc] pre-login hook.
+//
+// The hook [token], if set, is sent
+// in the "x-webitel-event-token" request metadata.
+type LoginHandlerClient interface {
+	// Approve (or deny) the end-User sign-in ; synchronous.
+	CheckLogin(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+}
+
+type loginHandlerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLoginHandlerClient(cc grpc.ClientConnInterface) LoginHandlerClient {
+	return &loginHandlerClient{cc}
+}
+
+func (c *loginHandlerClient) CheckLogin(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, LoginHandler_CheckLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LoginHandlerServer is the server API for LoginHandler service.
+// All implementations must embed UnimplementedLoginHandlerServer
+// for forward compatibility.
+//
+// LoginHandler is implemented by the App backend
+// of the [service.login_hook.grpc] pre-login hook.
+//
+// The hook [token], if set, is sent
+// in the "x-webitel-event-token" request metadata.
+type LoginHandlerServer interface {
+	// Approve (or deny) the end-User sign-in ; synchronous.
+	CheckLogin(context.Context, *LoginRequest) (*LoginResponse, error)
+	mustEmbedUnimplementedLoginHandlerServer()
+}
+
+// UnimplementedLoginHandlerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLoginHandlerServer struct{}
+
+func (UnimplementedLoginHandlerServer) CheckLogin(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckLogin not implemented")
+}
+func (UnimplementedLoginHandlerServer) mustEmbedUnimplementedLoginHandlerServer() {}
+func (UnimplementedLoginHandlerServer) testEmbeddedByValue()                      {}
+
+// UnsafeLoginHandlerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LoginHandlerServer will
+// result in compilation errors.
+type UnsafeLoginHandlerServer interface {
+	mustEmbedUnimplementedLoginHandlerServer()
+}
+
+func RegisterLoginHandlerServer(s grpc.ServiceRegistrar, srv LoginHandlerServer) {
+	// If the following call pancis, it indicates UnimplementedLoginHandlerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LoginHandler_ServiceDesc, srv)
+}
+
+func _LoginHandler_CheckLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginHandlerServer).CheckLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoginHandler_CheckLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginHandlerServer).CheckLogin(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LoginHandler_ServiceDesc is the grpc.ServiceDesc for LoginHandler service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LoginHandler_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.im.internal.client.v1.LoginHandler",
+	HandlerType: (*LoginHandlerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CheckLogin",
+			Handler:    _LoginHandler_CheckLogin_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "internal/client/v1/login.proto",
+}
@@ -0,0 +1,141 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: internal/client/v1/service_update.proto
+
+package clientpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	UpdateHandler_SendUpdates_FullMethodName = "/webitel.im.internal.client.v1.UpdateHandler/SendUpdates"
+)
+
+// UpdateHandlerClient is the client API for UpdateHandler service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// UpdateHandler is implemented by the partner service
+// of the App [service.send_update.grpc] subscription.
+//
+// The subscription [token], if set, is sent
+// in the "x-webitel-event-token" request metadata.
+type UpdateHandlerClient interface {
+	// Handle the batch of the App Update(s).
+	// Update(s) of the same contact are sent in order ; the next one
+	// is NOT sent until the previous is acknowledged or dead-lettered.
+	// Update(s) NOT acknowledged are sent again later ; at-least-once.
+	SendUpdates(ctx context.Context, in *SendUpdatesRequest, opts ...grpc.CallOption) (*SendUpdatesResponse, error)
+}
+
+type updateHandlerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUpdateHandlerClient(cc grpc.ClientConnInterface) UpdateHandlerClient {
+	return &updateHandlerClient{cc}
+}
+
+func (c *updateHandlerClient) SendUpdates(ctx context.Context, in *SendUpdatesRequest, opts ...grpc.CallOption) (*SendUpdatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendUpdatesResponse)
+	err := c.cc.Invoke(ctx, UpdateHandler_SendUpdates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateHandlerServer is the server API for UpdateHandler service.
+// All implementations must embed UnimplementedUpdateHandlerServer
+// for forward compatibility.
+//
+// UpdateHandler is implemented by the partner service
+// of the App [service.send_update.grpc] subscription.
+//
+// The subscription [token], if set, is sent
+// in the "x-webitel-event-token" request metadata.
+type UpdateHandlerServer interface {
+	// Handle the batch of the App Update(s).
+	// Update(s) of the same contact are sent in order ; the next one
+	// is NOT sent until the previous is acknowledged or dead-lettered.
+	// Update(s) NOT acknowledged are sent again later ; at-least-once.
+	SendUpdates(context.Context, *SendUpdatesRequest) (*SendUpdatesResponse, error)
+	mustEmbedUnimplementedUpdateHandlerServer()
+}
+
+// UnimplementedUpdateHandlerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUpdateHandlerServer struct{}
+
+func (UnimplementedUpdateHandlerServer) SendUpdates(context.Context, *SendUpdatesRequest) (*SendUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendUpdates not implemented")
+}
+func (UnimplementedUpdateHandlerServer) mustEmbedUnimplementedUpdateHandlerServer() {}
+func (UnimplementedUpdateHandlerServer) testEmbeddedByValue()                       {}
+
+// UnsafeUpdateHandlerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UpdateHandlerServer will
+// result in compilation errors.
+type UnsafeUpdateHandlerServer interface {
+	mustEmbedUnimplementedUpdateHandlerServer()
+}
+
+func RegisterUpdateHandlerServer(s grpc.ServiceRegistrar, srv UpdateHandlerServer) {
+	// If the following call pancis, it indicates UnimplementedUpdateHandlerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UpdateHandler_ServiceDesc, srv)
+}
+
+func _UpdateHandler_SendUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UpdateHandlerServer).SendUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UpdateHandler_SendUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UpdateHandlerServer).SendUpdates(ctx, req.(*SendUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UpdateHandler_ServiceDesc is the grpc.ServiceDesc for UpdateHandler service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UpdateHandler_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.im.internal.client.v1.UpdateHandler",
+	HandlerType: (*UpdateHandlerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendUpdates",
+			Handler:    _UpdateHandler_SendUpdates_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "internal/client/v1/service_update.proto",
+}
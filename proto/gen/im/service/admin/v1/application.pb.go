@@ -67,7 +67,7 @@ func (x WebhookSubscription_Codec) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use WebhookSubscription_Codec.Descriptor instead.
 func (WebhookSubscription_Codec) EnumDescriptor() ([]byte, []int) {
-	return file_service_admin_v1_application_proto_rawDescGZIP(), []int{3, 0}
+	return file_service_admin_v1_application_proto_rawDescGZIP(), []int{4, 0}
 }
 
 // Application (Access) Configuration.
@@ -95,6 +95,13 @@ type Application struct {
 	Account *Account `protobuf:"bytes,9,opt,name=account,proto3" json:"account,omitempty"`
 	// Optional. Defines special rules for Contacts list selection (I/O).
 	Contacts *ContactApp `protobuf:"bytes,10,opt,name=contacts,proto3" json:"contacts,omitempty"`
+	// Creation date. Unix timestamp (milliseconds)
+	CreatedAt int64 `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update date. Unix timestamp (milliseconds)
+	UpdatedAt int64 `protobuf:"varint,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Configuration version. Increments on every update.
+	// Used to detect concurrent modification(s).
+	Ver int32 `protobuf:"varint,13,opt,name=ver,proto3" json:"ver,omitempty"`
 }
 
 func (x *Application) Reset() {
@@ -192,6 +199,27 @@ func (x *Application) GetContacts() *ContactApp {
 	return nil
 }
 
+func (x *Application) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Application) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Application) GetVer() int32 {
+	if x != nil {
+		return x.Ver
+	}
+	return 0
+}
+
 // Application Service Configuration
 type ServiceApp struct {
 	state         protoimpl.MessageState
@@ -208,6 +236,9 @@ type ServiceApp struct {
 	// PUSH Notification Service account(s) available
 	// Server-to-Client / User (Notification) communication.
 	PushService *PUSHServiceClient `protobuf:"bytes,4,opt,name=push_service,json=pushService,proto3" json:"push_service,omitempty"`
+	// Pre-login hook. Server-to-Server (synchronous) communication.
+	// App backend approves (or enriches) the end-User identity before the session is issued.
+	LoginHook *LoginHook `protobuf:"bytes,5,opt,name=login_hook,json=loginHook,proto3" json:"login_hook,omitempty"`
 }
 
 func (x *ServiceApp) Reset() {
@@ -270,6 +301,139 @@ func (x *ServiceApp) GetPushService() *PUSHServiceClient {
 	return nil
 }
 
+func (x *ServiceApp) GetLoginHook() *LoginHook {
+	if x != nil {
+		return x.LoginHook
+	}
+	return nil
+}
+
+// Pre-login hook of the App backend policy.
+// The endpoint is called with the [webitel.im.internal.client.v1.LoginRequest]
+// and should respond with the [webitel.im.internal.client.v1.LoginResponse].
+type LoginHook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Endpoint to call ..
+	//
+	// Types that are assignable to Endpoint:
+	//
+	//	*LoginHook_Web
+	//	*LoginHook_Grpc
+	Endpoint isLoginHook_Endpoint `protobuf_oneof:"endpoint"`
+	// OPTIONAL. A secret token to be sent in a header “X-Webitel-Event-Token” in every hook request, 1-256 characters. Only characters A-Z, a-z, 0-9, _ and - are allowed.
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	// OPTIONAL. Hook request timeout (milliseconds) ; 100..30000. Default: 5000.
+	Timeout int32 `protobuf:"varint,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// OPTIONAL. Allow the sign-in when the hook is unavailable (failure or timeout).
+	// Default: fail-closed ; the sign-in is rejected.
+	FailOpen bool `protobuf:"varint,5,opt,name=fail_open,json=failOpen,proto3" json:"fail_open,omitempty"`
+	// OPTIONAL. A secret key to sign every webhook request body with ; 16-256 characters.
+	// Never transmitted ; the HMAC-SHA256 signature is sent in a header “X-Webitel-Event-Signature”.
+	Secret string `protobuf:"bytes,6,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *LoginHook) Reset() {
+	*x = LoginHook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_admin_v1_application_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginHook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginHook) ProtoMessage() {}
+
+func (x *LoginHook) ProtoReflect() protoreflect.Message {
+	mi := &file_service_admin_v1_application_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginHook.ProtoReflect.Descriptor instead.
+func (*LoginHook) Descriptor() ([]byte, []int) {
+	return file_service_admin_v1_application_proto_rawDescGZIP(), []int{2}
+}
+
+func (m *LoginHook) GetEndpoint() isLoginHook_Endpoint {
+	if m != nil {
+		return m.Endpoint
+	}
+	return nil
+}
+
+func (x *LoginHook) GetWeb() *WebhookSubscription {
+	if x, ok := x.GetEndpoint().(*LoginHook_Web); ok {
+		return x.Web
+	}
+	return nil
+}
+
+func (x *LoginHook) GetGrpc() *GrpcServiceSubscription {
+	if x, ok := x.GetEndpoint().(*LoginHook_Grpc); ok {
+		return x.Grpc
+	}
+	return nil
+}
+
+func (x *LoginHook) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *LoginHook) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *LoginHook) GetFailOpen() bool {
+	if x != nil {
+		return x.FailOpen
+	}
+	return false
+}
+
+func (x *LoginHook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type isLoginHook_Endpoint interface {
+	isLoginHook_Endpoint()
+}
+
+type LoginHook_Web struct {
+	// HTTP Webhook ; POST request
+	Web *WebhookSubscription `protobuf:"bytes,1,opt,name=web,proto3,oneof"`
+}
+
+type LoginHook_Grpc struct {
+	// gRPC Service ; implements [webitel.im.internal.client.v1.LoginHandler]
+	Grpc *GrpcServiceSubscription `protobuf:"bytes,2,opt,name=grpc,proto3,oneof"`
+}
+
+func (*LoginHook_Web) isLoginHook_Endpoint() {}
+
+func (*LoginHook_Grpc) isLoginHook_Endpoint() {}
+
 // Event [Updates] Subscription
 type EventSubscription struct {
 	state         protoimpl.MessageState
@@ -288,12 +452,15 @@ type EventSubscription struct {
 	// OPTIONAL. A secret token to be sent in a header “X-Webitel-Event-Token” in every webhook request, 1-256 characters. Only characters A-Z, a-z, 0-9, _ and - are allowed.
 	// The header is useful to ensure that the request comes from a webhook set by you.
 	Token string `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	// OPTIONAL. A secret key to sign every webhook request body with ; 16-256 characters.
+	// Never transmitted ; the HMAC-SHA256 signature is sent in a header “X-Webitel-Event-Signature”.
+	Secret string `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret,omitempty"`
 }
 
 func (x *EventSubscription) Reset() {
 	*x = EventSubscription{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_admin_v1_application_proto_msgTypes[2]
+		mi := &file_service_admin_v1_application_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -306,7 +473,7 @@ func (x *EventSubscription) String() string {
 func (*EventSubscription) ProtoMessage() {}
 
 func (x *EventSubscription) ProtoReflect() protoreflect.Message {
-	mi := &file_service_admin_v1_application_proto_msgTypes[2]
+	mi := &file_service_admin_v1_application_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -319,7 +486,7 @@ func (x *EventSubscription) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EventSubscription.ProtoReflect.Descriptor instead.
 func (*EventSubscription) Descriptor() ([]byte, []int) {
-	return file_service_admin_v1_application_proto_rawDescGZIP(), []int{2}
+	return file_service_admin_v1_application_proto_rawDescGZIP(), []int{3}
 }
 
 func (m *EventSubscription) GetEndpoint() isEventSubscription_Endpoint {
@@ -357,6 +524,13 @@ func (x *EventSubscription) GetToken() string {
 	return ""
 }
 
+func (x *EventSubscription) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
 type isEventSubscription_Endpoint interface {
 	isEventSubscription_Endpoint()
 }
@@ -394,7 +568,7 @@ type WebhookSubscription struct {
 func (x *WebhookSubscription) Reset() {
 	*x = WebhookSubscription{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_admin_v1_application_proto_msgTypes[3]
+		mi := &file_service_admin_v1_application_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -407,7 +581,7 @@ func (x *WebhookSubscription) String() string {
 func (*WebhookSubscription) ProtoMessage() {}
 
 func (x *WebhookSubscription) ProtoReflect() protoreflect.Message {
-	mi := &file_service_admin_v1_application_proto_msgTypes[3]
+	mi := &file_service_admin_v1_application_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -420,7 +594,7 @@ func (x *WebhookSubscription) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebhookSubscription.ProtoReflect.Descriptor instead.
 func (*WebhookSubscription) Descriptor() ([]byte, []int) {
-	return file_service_admin_v1_application_proto_rawDescGZIP(), []int{3}
+	return file_service_admin_v1_application_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *WebhookSubscription) GetUrl() string {
@@ -462,7 +636,7 @@ type GrpcServiceSubscription struct {
 func (x *GrpcServiceSubscription) Reset() {
 	*x = GrpcServiceSubscription{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_admin_v1_application_proto_msgTypes[4]
+		mi := &file_service_admin_v1_application_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -475,7 +649,7 @@ func (x *GrpcServiceSubscription) String() string {
 func (*GrpcServiceSubscription) ProtoMessage() {}
 
 func (x *GrpcServiceSubscription) ProtoReflect() protoreflect.Message {
-	mi := &file_service_admin_v1_application_proto_msgTypes[4]
+	mi := &file_service_admin_v1_application_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -488,7 +662,7 @@ func (x *GrpcServiceSubscription) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GrpcServiceSubscription.ProtoReflect.Descriptor instead.
 func (*GrpcServiceSubscription) Descriptor() ([]byte, []int) {
-	return file_service_admin_v1_application_proto_rawDescGZIP(), []int{4}
+	return file_service_admin_v1_application_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GrpcServiceSubscription) GetHost() string {
@@ -519,7 +693,7 @@ type Revocation struct {
 func (x *Revocation) Reset() {
 	*x = Revocation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_admin_v1_application_proto_msgTypes[5]
+		mi := &file_service_admin_v1_application_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -532,7 +706,7 @@ func (x *Revocation) String() string {
 func (*Revocation) ProtoMessage() {}
 
 func (x *Revocation) ProtoReflect() protoreflect.Message {
-	mi := &file_service_admin_v1_application_proto_msgTypes[5]
+	mi := &file_service_admin_v1_application_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -545,7 +719,7 @@ func (x *Revocation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Revocation.ProtoReflect.Descriptor instead.
 func (*Revocation) Descriptor() ([]byte, []int) {
-	return file_service_admin_v1_application_proto_rawDescGZIP(), []int{5}
+	return file_service_admin_v1_application_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Revocation) GetDate() int64 {
@@ -582,7 +756,7 @@ var file_service_admin_v1_application_proto_rawDesc = []byte{
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x63,
 	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9e, 0x03, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xee, 0x03, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x6c, 0x69,
 	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x64, 0x63, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x03, 0x52, 0x02, 0x64, 0x63, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
@@ -608,7 +782,12 @@ var file_service_admin_v1_application_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69,
 	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
 	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x41, 0x70, 0x70, 0x52, 0x08, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x22, 0x93, 0x02, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x03, 0x76, 0x65, 0x72, 0x22, 0xda, 0x02, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76,
 	0x69, 0x63, 0x65, 0x41, 0x70, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x49,
 	0x0a, 0x0b, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20,
@@ -625,21 +804,44 @@ var file_service_admin_v1_application_proto_rawDesc = []byte{
 	0x32, 0x2e, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65,
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50,
 	0x55, 0x53, 0x48, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x52, 0x0b, 0x70, 0x75, 0x73, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0xdf, 0x01,
-	0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x03, 0x77, 0x65, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x30, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x57,
-	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x77, 0x65, 0x62, 0x12, 0x4a, 0x0a, 0x04, 0x67, 0x72, 0x70,
-	0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65,
-	0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52,
-	0x04, 0x67, 0x72, 0x70, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22,
+	0x52, 0x0b, 0x70, 0x75, 0x73, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x45, 0x0a,
+	0x0a, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x09, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x48, 0x6f, 0x6f, 0x6b, 0x22, 0x8e, 0x02, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x48, 0x6f,
+	0x6f, 0x6b, 0x12, 0x44, 0x0a, 0x03, 0x77, 0x65, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x30, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x00, 0x52, 0x03, 0x77, 0x65, 0x62, 0x12, 0x4a, 0x0a, 0x04, 0x67, 0x72, 0x70, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c,
+	0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x04,
+	0x67, 0x72, 0x70, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x6f, 0x70, 0x65,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x4f, 0x70, 0x65,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0xf7, 0x01, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x03, 0x77,
+	0x65, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74,
+	0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x77, 0x65,
+	0x62, 0x12, 0x4a, 0x0a, 0x04, 0x67, 0x72, 0x70, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x34, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72,
+	0x70, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x04, 0x67, 0x72, 0x70, 0x63, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22,
 	0xa7, 0x01, 0x0a, 0x13, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63,
 	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64,
@@ -691,40 +893,44 @@ func file_service_admin_v1_application_proto_rawDescGZIP() []byte {
 }
 
 var file_service_admin_v1_application_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_service_admin_v1_application_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_service_admin_v1_application_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_service_admin_v1_application_proto_goTypes = []interface{}{
 	(WebhookSubscription_Codec)(0),  // 0: webitel.im.service.admin.v1.WebhookSubscription.Codec
 	(*Application)(nil),             // 1: webitel.im.service.admin.v1.Application
 	(*ServiceApp)(nil),              // 2: webitel.im.service.admin.v1.ServiceApp
-	(*EventSubscription)(nil),       // 3: webitel.im.service.admin.v1.EventSubscription
-	(*WebhookSubscription)(nil),     // 4: webitel.im.service.admin.v1.WebhookSubscription
-	(*GrpcServiceSubscription)(nil), // 5: webitel.im.service.admin.v1.GrpcServiceSubscription
-	(*Revocation)(nil),              // 6: webitel.im.service.admin.v1.Revocation
-	(*ClientApp)(nil),               // 7: webitel.im.service.admin.v1.ClientApp
-	(*Account)(nil),                 // 8: webitel.im.service.admin.v1.Account
-	(*ContactApp)(nil),              // 9: webitel.im.service.admin.v1.ContactApp
-	(*RateLimiter)(nil),             // 10: webitel.im.service.admin.v1.RateLimiter
-	(*PUSHServiceClient)(nil),       // 11: webitel.im.service.admin.v1.PUSHServiceClient
-	(*status.Status)(nil),           // 12: google.rpc.Status
+	(*LoginHook)(nil),               // 3: webitel.im.service.admin.v1.LoginHook
+	(*EventSubscription)(nil),       // 4: webitel.im.service.admin.v1.EventSubscription
+	(*WebhookSubscription)(nil),     // 5: webitel.im.service.admin.v1.WebhookSubscription
+	(*GrpcServiceSubscription)(nil), // 6: webitel.im.service.admin.v1.GrpcServiceSubscription
+	(*Revocation)(nil),              // 7: webitel.im.service.admin.v1.Revocation
+	(*ClientApp)(nil),               // 8: webitel.im.service.admin.v1.ClientApp
+	(*Account)(nil),                 // 9: webitel.im.service.admin.v1.Account
+	(*ContactApp)(nil),              // 10: webitel.im.service.admin.v1.ContactApp
+	(*RateLimiter)(nil),             // 11: webitel.im.service.admin.v1.RateLimiter
+	(*PUSHServiceClient)(nil),       // 12: webitel.im.service.admin.v1.PUSHServiceClient
+	(*status.Status)(nil),           // 13: google.rpc.Status
 }
 var file_service_admin_v1_application_proto_depIdxs = []int32{
-	6,  // 0: webitel.im.service.admin.v1.Application.block:type_name -> webitel.im.service.admin.v1.Revocation
-	7,  // 1: webitel.im.service.admin.v1.Application.client:type_name -> webitel.im.service.admin.v1.ClientApp
+	7,  // 0: webitel.im.service.admin.v1.Application.block:type_name -> webitel.im.service.admin.v1.Revocation
+	8,  // 1: webitel.im.service.admin.v1.Application.client:type_name -> webitel.im.service.admin.v1.ClientApp
 	2,  // 2: webitel.im.service.admin.v1.Application.service:type_name -> webitel.im.service.admin.v1.ServiceApp
-	8,  // 3: webitel.im.service.admin.v1.Application.account:type_name -> webitel.im.service.admin.v1.Account
-	9,  // 4: webitel.im.service.admin.v1.Application.contacts:type_name -> webitel.im.service.admin.v1.ContactApp
-	10, // 5: webitel.im.service.admin.v1.ServiceApp.rate_limits:type_name -> webitel.im.service.admin.v1.RateLimiter
-	3,  // 6: webitel.im.service.admin.v1.ServiceApp.send_update:type_name -> webitel.im.service.admin.v1.EventSubscription
-	11, // 7: webitel.im.service.admin.v1.ServiceApp.push_service:type_name -> webitel.im.service.admin.v1.PUSHServiceClient
-	4,  // 8: webitel.im.service.admin.v1.EventSubscription.web:type_name -> webitel.im.service.admin.v1.WebhookSubscription
-	5,  // 9: webitel.im.service.admin.v1.EventSubscription.grpc:type_name -> webitel.im.service.admin.v1.GrpcServiceSubscription
-	0,  // 10: webitel.im.service.admin.v1.WebhookSubscription.codec:type_name -> webitel.im.service.admin.v1.WebhookSubscription.Codec
-	12, // 11: webitel.im.service.admin.v1.Revocation.reason:type_name -> google.rpc.Status
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	9,  // 3: webitel.im.service.admin.v1.Application.account:type_name -> webitel.im.service.admin.v1.Account
+	10, // 4: webitel.im.service.admin.v1.Application.contacts:type_name -> webitel.im.service.admin.v1.ContactApp
+	11, // 5: webitel.im.service.admin.v1.ServiceApp.rate_limits:type_name -> webitel.im.service.admin.v1.RateLimiter
+	4,  // 6: webitel.im.service.admin.v1.ServiceApp.send_update:type_name -> webitel.im.service.admin.v1.EventSubscription
+	12, // 7: webitel.im.service.admin.v1.ServiceApp.push_service:type_name -> webitel.im.service.admin.v1.PUSHServiceClient
+	3,  // 8: webitel.im.service.admin.v1.ServiceApp.login_hook:type_name -> webitel.im.service.admin.v1.LoginHook
+	5,  // 9: webitel.im.service.admin.v1.LoginHook.web:type_name -> webitel.im.service.admin.v1.WebhookSubscription
+	6,  // 10: webitel.im.service.admin.v1.LoginHook.grpc:type_name -> webitel.im.service.admin.v1.GrpcServiceSubscription
+	5,  // 11: webitel.im.service.admin.v1.EventSubscription.web:type_name -> webitel.im.service.admin.v1.WebhookSubscription
+	6,  // 12: webitel.im.service.admin.v1.EventSubscription.grpc:type_name -> webitel.im.service.admin.v1.GrpcServiceSubscription
+	0,  // 13: webitel.im.service.admin.v1.WebhookSubscription.codec:type_name -> webitel.im.service.admin.v1.WebhookSubscription.Codec
+	13, // 14: webitel.im.service.admin.v1.Revocation.reason:type_name -> google.rpc.Status
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_service_admin_v1_application_proto_init() }
@@ -763,7 +969,7 @@ func file_service_admin_v1_application_proto_init() {
 			}
 		}
 		file_service_admin_v1_application_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventSubscription); i {
+			switch v := v.(*LoginHook); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -775,7 +981,7 @@ func file_service_admin_v1_application_proto_init() {
 			}
 		}
 		file_service_admin_v1_application_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WebhookSubscription); i {
+			switch v := v.(*EventSubscription); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -787,7 +993,7 @@ func file_service_admin_v1_application_proto_init() {
 			}
 		}
 		file_service_admin_v1_application_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GrpcServiceSubscription); i {
+			switch v := v.(*WebhookSubscription); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -799,6 +1005,18 @@ func file_service_admin_v1_application_proto_init() {
 			}
 		}
 		file_service_admin_v1_application_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcServiceSubscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_admin_v1_application_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Revocation); i {
 			case 0:
 				return &v.state
@@ -812,6 +1030,10 @@ func file_service_admin_v1_application_proto_init() {
 		}
 	}
 	file_service_admin_v1_application_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*LoginHook_Web)(nil),
+		(*LoginHook_Grpc)(nil),
+	}
+	file_service_admin_v1_application_proto_msgTypes[3].OneofWrappers = []interface{}{
 		(*EventSubscription_Web)(nil),
 		(*EventSubscription_Grpc)(nil),
 	}
@@ -821,7 +1043,7 @@ func file_service_admin_v1_application_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_service_admin_v1_application_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
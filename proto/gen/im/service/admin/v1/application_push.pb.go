@@ -32,6 +32,9 @@ type PUSHServiceClient struct {
 	Fcm *PushFCMServiceClient `protobuf:"bytes,2,opt,name=fcm,proto3" json:"fcm,omitempty"`
 	// iOS [A]pple [P]ush [N]otification service client
 	Apn *PushAPNServiceClient `protobuf:"bytes,3,opt,name=apn,proto3" json:"apn,omitempty"`
+	// Named notification template(s) ; [template] ID: [A-Za-z0-9_.-]{1,64}
+	// Notification sender(s) refer to the template by ID with the variable(s).
+	Templates map[string]*NotificationTemplate `protobuf:"bytes,4,rep,name=templates,proto3" json:"templates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *PUSHServiceClient) Reset() {
@@ -87,23 +90,144 @@ func (x *PUSHServiceClient) GetApn() *PushAPNServiceClient {
 	return nil
 }
 
+func (x *PUSHServiceClient) GetTemplates() map[string]*NotificationTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+// Localized notification template.
+// Title and body MAY contain {{name}} placeholder(s) of the notification variable(s).
+// Missing variable renders empty.
+type NotificationTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Default notification title ; fallback
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Default notification text ; fallback
+	Body string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	// OPTIONAL. Sound to play.
+	Sound string `protobuf:"bytes,3,opt,name=sound,proto3" json:"sound,omitempty"`
+	// OPTIONAL. App icon badge number ; iOS
+	Badge *int32 `protobuf:"varint,4,opt,name=badge,proto3,oneof" json:"badge,omitempty"`
+	// OPTIONAL. Notification category (actions set) identifier.
+	// [apn] aps.category ; [fcm] android.notification.click_action ; [web] category
+	Category string `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	// OPTIONAL. Collapse (thread) key.
+	Collapse string `protobuf:"bytes,6,opt,name=collapse,proto3" json:"collapse,omitempty"`
+	// Localized variant(s) by the BCP47 language tag, e.g.: en, en-US, uk-UA.
+	// Recipient contact's locale selects the exact tag variant,
+	// then the language (base) one, then the default title and body.
+	Locales map[string]*NotificationTemplate_Variant `protobuf:"bytes,7,rep,name=locales,proto3" json:"locales,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *NotificationTemplate) Reset() {
+	*x = NotificationTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_admin_v1_application_push_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationTemplate) ProtoMessage() {}
+
+func (x *NotificationTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_service_admin_v1_application_push_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationTemplate.ProtoReflect.Descriptor instead.
+func (*NotificationTemplate) Descriptor() ([]byte, []int) {
+	return file_service_admin_v1_application_push_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NotificationTemplate) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NotificationTemplate) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *NotificationTemplate) GetSound() string {
+	if x != nil {
+		return x.Sound
+	}
+	return ""
+}
+
+func (x *NotificationTemplate) GetBadge() int32 {
+	if x != nil && x.Badge != nil {
+		return *x.Badge
+	}
+	return 0
+}
+
+func (x *NotificationTemplate) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *NotificationTemplate) GetCollapse() string {
+	if x != nil {
+		return x.Collapse
+	}
+	return ""
+}
+
+func (x *NotificationTemplate) GetLocales() map[string]*NotificationTemplate_Variant {
+	if x != nil {
+		return x.Locales
+	}
+	return nil
+}
+
 // Webitel PUSH Service Client configuration
 type PushWebServiceClient struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Proxy URL to [POST] a notification message [TO] specified target.
+	// OPTIONAL. Proxy URL to [POST] a notification message [TO] specified target.
+	// The (encrypted) message is relayed instead of the subscription endpoint
+	// with the target subscription endpoint URL in a header “X-Webitel-PUSH-Endpoint”.
 	Proxy string `protobuf:"bytes,1,opt,name=proxy,proto3" json:"proxy,omitempty"`
 	// A secret token to be sent in a header “X-Webitel-PUSH-Token” in every notification request.
 	// The header is useful to ensure that the request comes from a Webitel PUSH service set by you.
 	Token []byte `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// [V]oluntary [A]pplication Server [ID]entification key pair.
+	// Generated for the application, if not specified.
+	// https://datatracker.ietf.org/doc/html/rfc8292
+	Vapid *PushWebServiceClient_VAPID `protobuf:"bytes,3,opt,name=vapid,proto3" json:"vapid,omitempty"`
 }
 
 func (x *PushWebServiceClient) Reset() {
 	*x = PushWebServiceClient{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_admin_v1_application_push_proto_msgTypes[1]
+		mi := &file_service_admin_v1_application_push_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -116,7 +240,7 @@ func (x *PushWebServiceClient) String() string {
 func (*PushWebServiceClient) ProtoMessage() {}
 
 func (x *PushWebServiceClient) ProtoReflect() protoreflect.Message {
-	mi := &file_service_admin_v1_application_push_proto_msgTypes[1]
+	mi := &file_service_admin_v1_application_push_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -129,7 +253,7 @@ func (x *PushWebServiceClient) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushWebServiceClient.ProtoReflect.Descriptor instead.
 func (*PushWebServiceClient) Descriptor() ([]byte, []int) {
-	return file_service_admin_v1_application_push_proto_rawDescGZIP(), []int{1}
+	return file_service_admin_v1_application_push_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *PushWebServiceClient) GetProxy() string {
@@ -146,6 +270,13 @@ func (x *PushWebServiceClient) GetToken() []byte {
 	return nil
 }
 
+func (x *PushWebServiceClient) GetVapid() *PushWebServiceClient_VAPID {
+	if x != nil {
+		return x.Vapid
+	}
+	return nil
+}
+
 // Android [F]irebase [C]loud [M]essaging Service Client configuration.
 type PushFCMServiceClient struct {
 	state         protoimpl.MessageState
@@ -163,7 +294,7 @@ type PushFCMServiceClient struct {
 func (x *PushFCMServiceClient) Reset() {
 	*x = PushFCMServiceClient{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_admin_v1_application_push_proto_msgTypes[2]
+		mi := &file_service_admin_v1_application_push_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -176,7 +307,7 @@ func (x *PushFCMServiceClient) String() string {
 func (*PushFCMServiceClient) ProtoMessage() {}
 
 func (x *PushFCMServiceClient) ProtoReflect() protoreflect.Message {
-	mi := &file_service_admin_v1_application_push_proto_msgTypes[2]
+	mi := &file_service_admin_v1_application_push_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -189,7 +320,7 @@ func (x *PushFCMServiceClient) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushFCMServiceClient.ProtoReflect.Descriptor instead.
 func (*PushFCMServiceClient) Descriptor() ([]byte, []int) {
-	return file_service_admin_v1_application_push_proto_rawDescGZIP(), []int{2}
+	return file_service_admin_v1_application_push_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *PushFCMServiceClient) GetProxy() string {
@@ -240,7 +371,7 @@ type PushAPNServiceClient struct {
 func (x *PushAPNServiceClient) Reset() {
 	*x = PushAPNServiceClient{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_admin_v1_application_push_proto_msgTypes[3]
+		mi := &file_service_admin_v1_application_push_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -253,7 +384,7 @@ func (x *PushAPNServiceClient) String() string {
 func (*PushAPNServiceClient) ProtoMessage() {}
 
 func (x *PushAPNServiceClient) ProtoReflect() protoreflect.Message {
-	mi := &file_service_admin_v1_application_push_proto_msgTypes[3]
+	mi := &file_service_admin_v1_application_push_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -266,7 +397,7 @@ func (x *PushAPNServiceClient) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushAPNServiceClient.ProtoReflect.Descriptor instead.
 func (*PushAPNServiceClient) Descriptor() ([]byte, []int) {
-	return file_service_admin_v1_application_push_proto_rawDescGZIP(), []int{3}
+	return file_service_admin_v1_application_push_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *PushAPNServiceClient) GetProxy() string {
@@ -304,6 +435,132 @@ func (x *PushAPNServiceClient) GetTls() *PushAPNServiceClient_TLSClient {
 	return nil
 }
 
+// Localized content variant
+type NotificationTemplate_Variant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Notification title ; default, if empty
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Notification text ; default, if empty
+	Body string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *NotificationTemplate_Variant) Reset() {
+	*x = NotificationTemplate_Variant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_admin_v1_application_push_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationTemplate_Variant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationTemplate_Variant) ProtoMessage() {}
+
+func (x *NotificationTemplate_Variant) ProtoReflect() protoreflect.Message {
+	mi := &file_service_admin_v1_application_push_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationTemplate_Variant.ProtoReflect.Descriptor instead.
+func (*NotificationTemplate_Variant) Descriptor() ([]byte, []int) {
+	return file_service_admin_v1_application_push_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *NotificationTemplate_Variant) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NotificationTemplate_Variant) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+// VAPID configuration
+type PushWebServiceClient_VAPID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// OPTIONAL. Contact URI of the application server, e.g.: mailto:push@example.com or https://example.com
+	Subject string `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	// P-256 ECDSA public key ; uncompressed point (65 bytes).
+	// The [applicationServerKey] option of the browser PushManager.subscribe().
+	PublicKey []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// P-256 ECDSA private key ; raw scalar (32 bytes).
+	PrivateKey []byte `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+}
+
+func (x *PushWebServiceClient_VAPID) Reset() {
+	*x = PushWebServiceClient_VAPID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_admin_v1_application_push_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushWebServiceClient_VAPID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushWebServiceClient_VAPID) ProtoMessage() {}
+
+func (x *PushWebServiceClient_VAPID) ProtoReflect() protoreflect.Message {
+	mi := &file_service_admin_v1_application_push_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushWebServiceClient_VAPID.ProtoReflect.Descriptor instead.
+func (*PushWebServiceClient_VAPID) Descriptor() ([]byte, []int) {
+	return file_service_admin_v1_application_push_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *PushWebServiceClient_VAPID) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *PushWebServiceClient_VAPID) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *PushWebServiceClient_VAPID) GetPrivateKey() []byte {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return nil
+}
+
 // Token/key configuration
 type PushAPNServiceClient_Token struct {
 	state         protoimpl.MessageState
@@ -326,7 +583,7 @@ type PushAPNServiceClient_Token struct {
 func (x *PushAPNServiceClient_Token) Reset() {
 	*x = PushAPNServiceClient_Token{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_admin_v1_application_push_proto_msgTypes[4]
+		mi := &file_service_admin_v1_application_push_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -339,7 +596,7 @@ func (x *PushAPNServiceClient_Token) String() string {
 func (*PushAPNServiceClient_Token) ProtoMessage() {}
 
 func (x *PushAPNServiceClient_Token) ProtoReflect() protoreflect.Message {
-	mi := &file_service_admin_v1_application_push_proto_msgTypes[4]
+	mi := &file_service_admin_v1_application_push_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -352,7 +609,7 @@ func (x *PushAPNServiceClient_Token) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushAPNServiceClient_Token.ProtoReflect.Descriptor instead.
 func (*PushAPNServiceClient_Token) Descriptor() ([]byte, []int) {
-	return file_service_admin_v1_application_push_proto_rawDescGZIP(), []int{3, 0}
+	return file_service_admin_v1_application_push_proto_rawDescGZIP(), []int{4, 0}
 }
 
 func (x *PushAPNServiceClient_Token) GetKeyId() string {
@@ -391,7 +648,7 @@ type PushAPNServiceClient_TLSClient struct {
 func (x *PushAPNServiceClient_TLSClient) Reset() {
 	*x = PushAPNServiceClient_TLSClient{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_admin_v1_application_push_proto_msgTypes[5]
+		mi := &file_service_admin_v1_application_push_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -404,7 +661,7 @@ func (x *PushAPNServiceClient_TLSClient) String() string {
 func (*PushAPNServiceClient_TLSClient) ProtoMessage() {}
 
 func (x *PushAPNServiceClient_TLSClient) ProtoReflect() protoreflect.Message {
-	mi := &file_service_admin_v1_application_push_proto_msgTypes[5]
+	mi := &file_service_admin_v1_application_push_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -417,7 +674,7 @@ func (x *PushAPNServiceClient_TLSClient) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushAPNServiceClient_TLSClient.ProtoReflect.Descriptor instead.
 func (*PushAPNServiceClient_TLSClient) Descriptor() ([]byte, []int) {
-	return file_service_admin_v1_application_push_proto_rawDescGZIP(), []int{3, 1}
+	return file_service_admin_v1_application_push_proto_rawDescGZIP(), []int{4, 1}
 }
 
 func (x *PushAPNServiceClient_TLSClient) GetCert() []byte {
@@ -441,7 +698,7 @@ var file_service_admin_v1_application_push_proto_rawDesc = []byte{
 	0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
 	0x75, 0x73, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x77, 0x65, 0x62, 0x69, 0x74,
 	0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x22, 0xe2, 0x01, 0x0a, 0x11, 0x50, 0x55, 0x53, 0x48, 0x53,
+	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x22, 0xb0, 0x03, 0x0a, 0x11, 0x50, 0x55, 0x53, 0x48, 0x53,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x03,
 	0x77, 0x65, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x77, 0x65, 0x62, 0x69,
 	0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61,
@@ -455,57 +712,108 @@ var file_service_admin_v1_application_push_proto_rawDesc = []byte{
 	0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d,
 	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
 	0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x41, 0x50, 0x4e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x03, 0x61, 0x70, 0x6e, 0x22, 0x42, 0x0a, 0x14, 0x50,
-	0x75, 0x73, 0x68, 0x57, 0x65, 0x62, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0x46, 0x0a, 0x14, 0x50, 0x75, 0x73, 0x68, 0x46, 0x43, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xff, 0x02, 0x0a, 0x14, 0x50, 0x75, 0x73, 0x68,
-	0x41, 0x50, 0x4e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70,
-	0x69, 0x63, 0x12, 0x4d, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x37, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x75, 0x73, 0x68, 0x41, 0x50, 0x4e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x4d, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x03, 0x61, 0x70, 0x6e, 0x12, 0x5b, 0x0a, 0x09, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d,
+	0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x55, 0x53,
+	0x48, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x1a, 0x6f, 0x0a, 0x0e, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x47, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x77, 0x65,
+	0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb9, 0x03, 0x0a, 0x14, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x19, 0x0a, 0x05, 0x62, 0x61, 0x64, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x00, 0x52, 0x05, 0x62, 0x61, 0x64, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6c,
+	0x6c, 0x61, 0x70, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6c,
+	0x6c, 0x61, 0x70, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c,
+	0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x73, 0x1a,
+	0x75, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x4f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x39, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x33, 0x0a, 0x07, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x62, 0x61, 0x64, 0x67, 0x65, 0x22, 0xf4, 0x01, 0x0a, 0x14, 0x50, 0x75, 0x73, 0x68, 0x57, 0x65,
+	0x62, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x4d, 0x0a, 0x05, 0x76, 0x61,
+	0x70, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x77, 0x65, 0x62, 0x69,
+	0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x57, 0x65, 0x62, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x41, 0x50,
+	0x49, 0x44, 0x52, 0x05, 0x76, 0x61, 0x70, 0x69, 0x64, 0x1a, 0x61, 0x0a, 0x05, 0x56, 0x41, 0x50,
+	0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x22, 0x46, 0x0a, 0x14,
+	0x50, 0x75, 0x73, 0x68, 0x46, 0x43, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xff, 0x02, 0x0a, 0x14, 0x50, 0x75, 0x73, 0x68, 0x41, 0x50, 0x4e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12,
+	0x4d, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37,
 	0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76,
 	0x69, 0x63, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73,
 	0x68, 0x41, 0x50, 0x4e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x2e, 0x54, 0x4c, 0x53, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x03, 0x74, 0x6c, 0x73,
-	0x1a, 0x52, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64,
-	0x12, 0x19, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x74,
-	0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65,
-	0x61, 0x6d, 0x49, 0x64, 0x1a, 0x33, 0x0a, 0x09, 0x54, 0x4c, 0x53, 0x43, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x65, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x04, 0x63, 0x65, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x70, 0x6b, 0x65, 0x79, 0x42, 0xff, 0x01, 0x0a, 0x1f, 0x63, 0x6f,
-	0x6d, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x14, 0x41,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
-	0x2f, 0x69, 0x6d, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x70, 0x62, 0xa2, 0x02, 0x04, 0x57,
-	0x49, 0x53, 0x41, 0xaa, 0x02, 0x1b, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x49, 0x6d,
-	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x1b, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d, 0x5c, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x5c, 0x56, 0x31, 0xe2,
-	0x02, 0x27, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d, 0x5c, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x5c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1f, 0x57, 0x65, 0x62, 0x69,
-	0x74, 0x65, 0x6c, 0x3a, 0x3a, 0x49, 0x6d, 0x3a, 0x3a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x3a, 0x3a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x74, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x4d,
+	0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x77, 0x65,
+	0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x41, 0x50,
+	0x4e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x54,
+	0x4c, 0x53, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x1a, 0x52, 0x0a,
+	0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x61, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x1a, 0x33, 0x0a, 0x09, 0x54, 0x4c, 0x53, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x65, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x65,
+	0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x70, 0x6b, 0x65, 0x79, 0x42, 0xff, 0x01, 0x0a, 0x1f, 0x63, 0x6f, 0x6d, 0x2e, 0x77,
+	0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x14, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77,
+	0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x69, 0x6d,
+	0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76,
+	0x31, 0x3b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x70, 0x62, 0xa2, 0x02, 0x04, 0x57, 0x49, 0x53, 0x41,
+	0xaa, 0x02, 0x1b, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x49, 0x6d, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x1b, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d, 0x5c, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x27, 0x57,
+	0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d, 0x5c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1f, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c,
+	0x3a, 0x3a, 0x49, 0x6d, 0x3a, 0x3a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3a, 0x3a, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -520,26 +828,36 @@ func file_service_admin_v1_application_push_proto_rawDescGZIP() []byte {
 	return file_service_admin_v1_application_push_proto_rawDescData
 }
 
-var file_service_admin_v1_application_push_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_service_admin_v1_application_push_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_service_admin_v1_application_push_proto_goTypes = []interface{}{
 	(*PUSHServiceClient)(nil),              // 0: webitel.im.service.admin.v1.PUSHServiceClient
-	(*PushWebServiceClient)(nil),           // 1: webitel.im.service.admin.v1.PushWebServiceClient
-	(*PushFCMServiceClient)(nil),           // 2: webitel.im.service.admin.v1.PushFCMServiceClient
-	(*PushAPNServiceClient)(nil),           // 3: webitel.im.service.admin.v1.PushAPNServiceClient
-	(*PushAPNServiceClient_Token)(nil),     // 4: webitel.im.service.admin.v1.PushAPNServiceClient.Token
-	(*PushAPNServiceClient_TLSClient)(nil), // 5: webitel.im.service.admin.v1.PushAPNServiceClient.TLSClient
+	(*NotificationTemplate)(nil),           // 1: webitel.im.service.admin.v1.NotificationTemplate
+	(*PushWebServiceClient)(nil),           // 2: webitel.im.service.admin.v1.PushWebServiceClient
+	(*PushFCMServiceClient)(nil),           // 3: webitel.im.service.admin.v1.PushFCMServiceClient
+	(*PushAPNServiceClient)(nil),           // 4: webitel.im.service.admin.v1.PushAPNServiceClient
+	nil,                                    // 5: webitel.im.service.admin.v1.PUSHServiceClient.TemplatesEntry
+	nil,                                    // 6: webitel.im.service.admin.v1.NotificationTemplate.LocalesEntry
+	(*NotificationTemplate_Variant)(nil),   // 7: webitel.im.service.admin.v1.NotificationTemplate.Variant
+	(*PushWebServiceClient_VAPID)(nil),     // 8: webitel.im.service.admin.v1.PushWebServiceClient.VAPID
+	(*PushAPNServiceClient_Token)(nil),     // 9: webitel.im.service.admin.v1.PushAPNServiceClient.Token
+	(*PushAPNServiceClient_TLSClient)(nil), // 10: webitel.im.service.admin.v1.PushAPNServiceClient.TLSClient
 }
 var file_service_admin_v1_application_push_proto_depIdxs = []int32{
-	1, // 0: webitel.im.service.admin.v1.PUSHServiceClient.web:type_name -> webitel.im.service.admin.v1.PushWebServiceClient
-	2, // 1: webitel.im.service.admin.v1.PUSHServiceClient.fcm:type_name -> webitel.im.service.admin.v1.PushFCMServiceClient
-	3, // 2: webitel.im.service.admin.v1.PUSHServiceClient.apn:type_name -> webitel.im.service.admin.v1.PushAPNServiceClient
-	4, // 3: webitel.im.service.admin.v1.PushAPNServiceClient.token:type_name -> webitel.im.service.admin.v1.PushAPNServiceClient.Token
-	5, // 4: webitel.im.service.admin.v1.PushAPNServiceClient.tls:type_name -> webitel.im.service.admin.v1.PushAPNServiceClient.TLSClient
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	2,  // 0: webitel.im.service.admin.v1.PUSHServiceClient.web:type_name -> webitel.im.service.admin.v1.PushWebServiceClient
+	3,  // 1: webitel.im.service.admin.v1.PUSHServiceClient.fcm:type_name -> webitel.im.service.admin.v1.PushFCMServiceClient
+	4,  // 2: webitel.im.service.admin.v1.PUSHServiceClient.apn:type_name -> webitel.im.service.admin.v1.PushAPNServiceClient
+	5,  // 3: webitel.im.service.admin.v1.PUSHServiceClient.templates:type_name -> webitel.im.service.admin.v1.PUSHServiceClient.TemplatesEntry
+	6,  // 4: webitel.im.service.admin.v1.NotificationTemplate.locales:type_name -> webitel.im.service.admin.v1.NotificationTemplate.LocalesEntry
+	8,  // 5: webitel.im.service.admin.v1.PushWebServiceClient.vapid:type_name -> webitel.im.service.admin.v1.PushWebServiceClient.VAPID
+	9,  // 6: webitel.im.service.admin.v1.PushAPNServiceClient.token:type_name -> webitel.im.service.admin.v1.PushAPNServiceClient.Token
+	10, // 7: webitel.im.service.admin.v1.PushAPNServiceClient.tls:type_name -> webitel.im.service.admin.v1.PushAPNServiceClient.TLSClient
+	1,  // 8: webitel.im.service.admin.v1.PUSHServiceClient.TemplatesEntry.value:type_name -> webitel.im.service.admin.v1.NotificationTemplate
+	7,  // 9: webitel.im.service.admin.v1.NotificationTemplate.LocalesEntry.value:type_name -> webitel.im.service.admin.v1.NotificationTemplate.Variant
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_service_admin_v1_application_push_proto_init() }
@@ -561,7 +879,7 @@ func file_service_admin_v1_application_push_proto_init() {
 			}
 		}
 		file_service_admin_v1_application_push_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushWebServiceClient); i {
+			switch v := v.(*NotificationTemplate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -573,7 +891,7 @@ func file_service_admin_v1_application_push_proto_init() {
 			}
 		}
 		file_service_admin_v1_application_push_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushFCMServiceClient); i {
+			switch v := v.(*PushWebServiceClient); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -585,7 +903,7 @@ func file_service_admin_v1_application_push_proto_init() {
 			}
 		}
 		file_service_admin_v1_application_push_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushAPNServiceClient); i {
+			switch v := v.(*PushFCMServiceClient); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -597,6 +915,42 @@ func file_service_admin_v1_application_push_proto_init() {
 			}
 		}
 		file_service_admin_v1_application_push_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushAPNServiceClient); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_admin_v1_application_push_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationTemplate_Variant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_admin_v1_application_push_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushWebServiceClient_VAPID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_admin_v1_application_push_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PushAPNServiceClient_Token); i {
 			case 0:
 				return &v.state
@@ -608,7 +962,7 @@ func file_service_admin_v1_application_push_proto_init() {
 				return nil
 			}
 		}
-		file_service_admin_v1_application_push_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_service_admin_v1_application_push_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PushAPNServiceClient_TLSClient); i {
 			case 0:
 				return &v.state
@@ -621,13 +975,14 @@ func file_service_admin_v1_application_push_proto_init() {
 			}
 		}
 	}
+	file_service_admin_v1_application_push_proto_msgTypes[1].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_service_admin_v1_application_push_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,989 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        (unknown)
+// source: service/admin/v1/push_delivery.proto
+
+package adminpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PushDelivery_State int32
+
+const (
+	// Awaiting the (next) delivery attempt.
+	PushDelivery_PENDING PushDelivery_State = 0
+	// Dead-letter ; permanent failure or attempts exhausted. No more retries.
+	PushDelivery_FAILED PushDelivery_State = 1
+)
+
+// Enum value maps for PushDelivery_State.
+var (
+	PushDelivery_State_name = map[int32]string{
+		0: "PENDING",
+		1: "FAILED",
+	}
+	PushDelivery_State_value = map[string]int32{
+		"PENDING": 0,
+		"FAILED":  1,
+	}
+)
+
+func (x PushDelivery_State) Enum() *PushDelivery_State {
+	p := new(PushDelivery_State)
+	*p = x
+	return p
+}
+
+func (x PushDelivery_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PushDelivery_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_admin_v1_push_delivery_proto_enumTypes[0].Descriptor()
+}
+
+func (PushDelivery_State) Type() protoreflect.EnumType {
+	return &file_service_admin_v1_push_delivery_proto_enumTypes[0]
+}
+
+func (x PushDelivery_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PushDelivery_State.Descriptor instead.
+func (PushDelivery_State) EnumDescriptor() ([]byte, []int) {
+	return file_service_admin_v1_push_delivery_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type TestPushResponse_Outcome int32
+
+const (
+	// Accepted by the PUSH service.
+	TestPushResponse_DELIVERED TestPushResponse_Outcome = 0
+	// Transient failure ; MAY be sent later.
+	TestPushResponse_RETRY TestPushResponse_Outcome = 1
+	// Permanent failure.
+	TestPushResponse_FAILED TestPushResponse_Outcome = 2
+	// Device token is no longer valid.
+	TestPushResponse_UNREGISTERED TestPushResponse_Outcome = 3
+)
+
+// Enum value maps for TestPushResponse_Outcome.
+var (
+	TestPushResponse_Outcome_name = map[int32]string{
+		0: "DELIVERED",
+		1: "RETRY",
+		2: "FAILED",
+		3: "UNREGISTERED",
+	}
+	TestPushResponse_Outcome_value = map[string]int32{
+		"DELIVERED":    0,
+		"RETRY":        1,
+		"FAILED":       2,
+		"UNREGISTERED": 3,
+	}
+)
+
+func (x TestPushResponse_Outcome) Enum() *TestPushResponse_Outcome {
+	p := new(TestPushResponse_Outcome)
+	*p = x
+	return p
+}
+
+func (x TestPushResponse_Outcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TestPushResponse_Outcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_admin_v1_push_delivery_proto_enumTypes[1].Descriptor()
+}
+
+func (TestPushResponse_Outcome) Type() protoreflect.EnumType {
+	return &file_service_admin_v1_push_delivery_proto_enumTypes[1]
+}
+
+func (x TestPushResponse_Outcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TestPushResponse_Outcome.Descriptor instead.
+func (TestPushResponse_Outcome) EnumDescriptor() ([]byte, []int) {
+	return file_service_admin_v1_push_delivery_proto_rawDescGZIP(), []int{4, 0}
+}
+
+type TestPushResponse_Cause int32
+
+const (
+	// Delivered.
+	TestPushResponse_NONE TestPushResponse_Cause = 0
+	// App PUSH service NOT configured or invalid.
+	TestPushResponse_CONFIG TestPushResponse_Cause = 1
+	// PUSH service credentials rejected.
+	TestPushResponse_CREDENTIALS TestPushResponse_Cause = 2
+	// Device token is missing, invalid or expired.
+	TestPushResponse_TOKEN TestPushResponse_Cause = 3
+	// PUSH service throttled or unavailable.
+	TestPushResponse_PROVIDER TestPushResponse_Cause = 4
+	// Transport-level failure ; no response.
+	TestPushResponse_NETWORK TestPushResponse_Cause = 5
+	// Message rejected by the PUSH service.
+	TestPushResponse_REQUEST TestPushResponse_Cause = 6
+)
+
+// Enum value maps for TestPushResponse_Cause.
+var (
+	TestPushResponse_Cause_name = map[int32]string{
+		0: "NONE",
+		1: "CONFIG",
+		2: "CREDENTIALS",
+		3: "TOKEN",
+		4: "PROVIDER",
+		5: "NETWORK",
+		6: "REQUEST",
+	}
+	TestPushResponse_Cause_value = map[string]int32{
+		"NONE":        0,
+		"CONFIG":      1,
+		"CREDENTIALS": 2,
+		"TOKEN":       3,
+		"PROVIDER":    4,
+		"NETWORK":     5,
+		"REQUEST":     6,
+	}
+)
+
+func (x TestPushResponse_Cause) Enum() *TestPushResponse_Cause {
+	p := new(TestPushResponse_Cause)
+	*p = x
+	return p
+}
+
+func (x TestPushResponse_Cause) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TestPushResponse_Cause) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_admin_v1_push_delivery_proto_enumTypes[2].Descriptor()
+}
+
+func (TestPushResponse_Cause) Type() protoreflect.EnumType {
+	return &file_service_admin_v1_push_delivery_proto_enumTypes[2]
+}
+
+func (x TestPushResponse_Cause) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TestPushResponse_Cause.Descriptor instead.
+func (TestPushResponse_Cause) EnumDescriptor() ([]byte, []int) {
+	return file_service_admin_v1_push_delivery_proto_rawDescGZIP(), []int{4, 1}
+}
+
+// PUSH notification delivery ; outbox record.
+type PushDelivery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Delivery ID
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Recipient session ID
+	SessionId string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Session App [client_id]
+	AppId string `protobuf:"bytes,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// PUSH service provider: fcm | apn | web
+	Provider string `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Delivery state
+	State PushDelivery_State `protobuf:"varint,5,opt,name=state,proto3,enum=webitel.im.service.admin.v1.PushDelivery_State" json:"state,omitempty"`
+	// Number of the delivery attempt(s) made
+	Attempts int32 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Next attempt date ; PENDING. Unix timestamp (milliseconds)
+	NextAt int64 `protobuf:"varint,7,opt,name=next_at,json=nextAt,proto3" json:"next_at,omitempty"`
+	// Enqueue date. Unix timestamp (milliseconds)
+	CreatedAt int64 `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last attempt date. Unix timestamp (milliseconds)
+	UpdatedAt int64 `protobuf:"varint,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Last attempt PUSH service HTTP status code, if any
+	Status int32 `protobuf:"varint,10,opt,name=status,proto3" json:"status,omitempty"`
+	// Last attempt PUSH service error reason code, e.g.: QUOTA_EXCEEDED, TooManyRequests
+	Reason string `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Last attempt error details
+	Error string `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *PushDelivery) Reset() {
+	*x = PushDelivery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_admin_v1_push_delivery_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushDelivery) ProtoMessage() {}
+
+func (x *PushDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_service_admin_v1_push_delivery_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushDelivery.ProtoReflect.Descriptor instead.
+func (*PushDelivery) Descriptor() ([]byte, []int) {
+	return file_service_admin_v1_push_delivery_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PushDelivery) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PushDelivery) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PushDelivery) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *PushDelivery) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *PushDelivery) GetState() PushDelivery_State {
+	if x != nil {
+		return x.State
+	}
+	return PushDelivery_PENDING
+}
+
+func (x *PushDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *PushDelivery) GetNextAt() int64 {
+	if x != nil {
+		return x.NextAt
+	}
+	return 0
+}
+
+func (x *PushDelivery) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *PushDelivery) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *PushDelivery) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *PushDelivery) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PushDelivery) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type PushDeliveryList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of the delivery record(s) ; latest first
+	Data []*PushDelivery `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// Number of the current dataset page.
+	Page int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	// Is there more results ?
+	Next bool `protobuf:"varint,3,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (x *PushDeliveryList) Reset() {
+	*x = PushDeliveryList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_admin_v1_push_delivery_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushDeliveryList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushDeliveryList) ProtoMessage() {}
+
+func (x *PushDeliveryList) ProtoReflect() protoreflect.Message {
+	mi := &file_service_admin_v1_push_delivery_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushDeliveryList.ProtoReflect.Descriptor instead.
+func (*PushDeliveryList) Descriptor() ([]byte, []int) {
+	return file_service_admin_v1_push_delivery_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PushDeliveryList) GetData() []*PushDelivery {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PushDeliveryList) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *PushDeliveryList) GetNext() bool {
+	if x != nil {
+		return x.Next
+	}
+	return false
+}
+
+type ListPushDeliveriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Filter by App [client_id]
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// Filter by recipient session ID
+	SessionId string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Filter by delivery state(s) ; ALL if none
+	State []PushDelivery_State `protobuf:"varint,3,rep,packed,name=state,proto3,enum=webitel.im.service.admin.v1.PushDelivery_State" json:"state,omitempty"`
+	// Page number. Offset
+	Page int32 `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	// Size number. Limit records per page ; default: 100, max: 1000
+	Size int32 `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *ListPushDeliveriesRequest) Reset() {
+	*x = ListPushDeliveriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_admin_v1_push_delivery_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPushDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPushDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListPushDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_admin_v1_push_delivery_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPushDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListPushDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_service_admin_v1_push_delivery_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListPushDeliveriesRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *ListPushDeliveriesRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ListPushDeliveriesRequest) GetState() []PushDelivery_State {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *ListPushDeliveriesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPushDeliveriesRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type TestPushRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// App [client_id] ; REQUIRED with the raw [token].
+	// Session App, if omitted with [session_id].
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// Recipient session ID ; test the session device registration.
+	SessionId string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Session registration type: alert | voip | web ; [session_id].
+	// Default: the primary registration.
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// Raw device token PUSH service provider: fcm | apn | web ; unless [session_id].
+	Provider string `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Raw device token ; unless [session_id].
+	// fcm: registration token ; apn: device token ;
+	// web: subscription JSON, e.g.: {"endpoint":"..","keys":{"p256dh":"..","auth":".."}}
+	Token string `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	// Raw [apn] token is the VoIP (PushKit) registration.
+	Voip bool `protobuf:"varint,6,opt,name=voip,proto3" json:"voip,omitempty"`
+	// OPTIONAL. Notification title. Default: test message
+	Title string `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
+	// OPTIONAL. Notification text. Default: test message
+	Body string `protobuf:"bytes,8,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *TestPushRequest) Reset() {
+	*x = TestPushRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_admin_v1_push_delivery_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestPushRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestPushRequest) ProtoMessage() {}
+
+func (x *TestPushRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_admin_v1_push_delivery_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestPushRequest.ProtoReflect.Descriptor instead.
+func (*TestPushRequest) Descriptor() ([]byte, []int) {
+	return file_service_admin_v1_push_delivery_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TestPushRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *TestPushRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *TestPushRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TestPushRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *TestPushRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *TestPushRequest) GetVoip() bool {
+	if x != nil {
+		return x.Voip
+	}
+	return false
+}
+
+func (x *TestPushRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TestPushRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type TestPushResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// App [client_id]
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// Recipient session ID, if given
+	SessionId string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// PUSH service provider: fcm | apn | web
+	Provider string `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Delivery attempt outcome
+	Outcome TestPushResponse_Outcome `protobuf:"varint,4,opt,name=outcome,proto3,enum=webitel.im.service.admin.v1.TestPushResponse_Outcome" json:"outcome,omitempty"`
+	// Classified failure cause ; NONE if DELIVERED
+	Cause TestPushResponse_Cause `protobuf:"varint,5,opt,name=cause,proto3,enum=webitel.im.service.admin.v1.TestPushResponse_Cause" json:"cause,omitempty"`
+	// PUSH service HTTP status code, if any
+	Status int32 `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`
+	// PUSH service error reason code, e.g.: BadDeviceToken, UNREGISTERED
+	Reason string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	// PUSH service message ID ; DELIVERED
+	MessageId string `protobuf:"bytes,8,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// Error details
+	Error string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	// Raw PUSH service response body, if any
+	Response string `protobuf:"bytes,10,opt,name=response,proto3" json:"response,omitempty"`
+	// Delivery attempt latency (milliseconds)
+	Latency int64 `protobuf:"varint,11,opt,name=latency,proto3" json:"latency,omitempty"`
+}
+
+func (x *TestPushResponse) Reset() {
+	*x = TestPushResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_admin_v1_push_delivery_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestPushResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestPushResponse) ProtoMessage() {}
+
+func (x *TestPushResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_admin_v1_push_delivery_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestPushResponse.ProtoReflect.Descriptor instead.
+func (*TestPushResponse) Descriptor() ([]byte, []int) {
+	return file_service_admin_v1_push_delivery_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TestPushResponse) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *TestPushResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *TestPushResponse) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *TestPushResponse) GetOutcome() TestPushResponse_Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return TestPushResponse_DELIVERED
+}
+
+func (x *TestPushResponse) GetCause() TestPushResponse_Cause {
+	if x != nil {
+		return x.Cause
+	}
+	return TestPushResponse_NONE
+}
+
+func (x *TestPushResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *TestPushResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TestPushResponse) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *TestPushResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *TestPushResponse) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *TestPushResponse) GetLatency() int64 {
+	if x != nil {
+		return x.Latency
+	}
+	return 0
+}
+
+var File_service_admin_v1_push_delivery_proto protoreflect.FileDescriptor
+
+var file_service_admin_v1_push_delivery_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e,
+	0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x76, 0x31, 0x22, 0x92, 0x03, 0x0a, 0x0c, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e,
+	0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x65, 0x78, 0x74,
+	0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x20, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x22, 0x79, 0x0a, 0x10, 0x50, 0x75, 0x73, 0x68,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x65, 0x62,
+	0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6e,
+	0x65, 0x78, 0x74, 0x22, 0xc0, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x75, 0x73, 0x68,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c,
+	0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xcb, 0x01, 0x0a, 0x0f, 0x54, 0x65, 0x73, 0x74, 0x50,
+	0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70,
+	0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x6f, 0x69, 0x70, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x76, 0x6f, 0x69, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x62, 0x6f, 0x64, 0x79, 0x22, 0xc1, 0x04, 0x0a, 0x10, 0x54, 0x65, 0x73, 0x74, 0x50, 0x75, 0x73,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x4f, 0x0a, 0x07, 0x6f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x77,
+	0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x50,
+	0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x75, 0x74, 0x63,
+	0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x49, 0x0a, 0x05,
+	0x63, 0x61, 0x75, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x77, 0x65,
+	0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x50, 0x75,
+	0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x61, 0x75, 0x73, 0x65,
+	0x52, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x22, 0x41, 0x0a, 0x07, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x0d, 0x0a,
+	0x09, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
+	0x52, 0x45, 0x54, 0x52, 0x59, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45,
+	0x52, 0x45, 0x44, 0x10, 0x03, 0x22, 0x61, 0x0a, 0x05, 0x43, 0x61, 0x75, 0x73, 0x65, 0x12, 0x08,
+	0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f, 0x4e, 0x46,
+	0x49, 0x47, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49,
+	0x41, 0x4c, 0x53, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x03,
+	0x12, 0x0c, 0x0a, 0x08, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x10, 0x04, 0x12, 0x0b,
+	0x0a, 0x07, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x52,
+	0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x06, 0x42, 0xfc, 0x01, 0x0a, 0x1f, 0x63, 0x6f, 0x6d,
+	0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x11, 0x50, 0x75,
+	0x73, 0x68, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65,
+	0x62, 0x69, 0x74, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x69, 0x6d, 0x2f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31,
+	0x3b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x70, 0x62, 0xa2, 0x02, 0x04, 0x57, 0x49, 0x53, 0x41, 0xaa,
+	0x02, 0x1b, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x49, 0x6d, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1b,
+	0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d, 0x5c, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x27, 0x57, 0x65,
+	0x62, 0x69, 0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d, 0x5c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1f, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x3a,
+	0x3a, 0x49, 0x6d, 0x3a, 0x3a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3a, 0x3a, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_service_admin_v1_push_delivery_proto_rawDescOnce sync.Once
+	file_service_admin_v1_push_delivery_proto_rawDescData = file_service_admin_v1_push_delivery_proto_rawDesc
+)
+
+func file_service_admin_v1_push_delivery_proto_rawDescGZIP() []byte {
+	file_service_admin_v1_push_delivery_proto_rawDescOnce.Do(func() {
+		file_service_admin_v1_push_delivery_proto_rawDescData = protoimpl.X.CompressGZIP(file_service_admin_v1_push_delivery_proto_rawDescData)
+	})
+	return file_service_admin_v1_push_delivery_proto_rawDescData
+}
+
+var file_service_admin_v1_push_delivery_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_service_admin_v1_push_delivery_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_service_admin_v1_push_delivery_proto_goTypes = []interface{}{
+	(PushDelivery_State)(0),           // 0: webitel.im.service.admin.v1.PushDelivery.State
+	(TestPushResponse_Outcome)(0),     // 1: webitel.im.service.admin.v1.TestPushResponse.Outcome
+	(TestPushResponse_Cause)(0),       // 2: webitel.im.service.admin.v1.TestPushResponse.Cause
+	(*PushDelivery)(nil),              // 3: webitel.im.service.admin.v1.PushDelivery
+	(*PushDeliveryList)(nil),          // 4: webitel.im.service.admin.v1.PushDeliveryList
+	(*ListPushDeliveriesRequest)(nil), // 5: webitel.im.service.admin.v1.ListPushDeliveriesRequest
+	(*TestPushRequest)(nil),           // 6: webitel.im.service.admin.v1.TestPushRequest
+	(*TestPushResponse)(nil),          // 7: webitel.im.service.admin.v1.TestPushResponse
+}
+var file_service_admin_v1_push_delivery_proto_depIdxs = []int32{
+	0, // 0: webitel.im.service.admin.v1.PushDelivery.state:type_name -> webitel.im.service.admin.v1.PushDelivery.State
+	3, // 1: webitel.im.service.admin.v1.PushDeliveryList.data:type_name -> webitel.im.service.admin.v1.PushDelivery
+	0, // 2: webitel.im.service.admin.v1.ListPushDeliveriesRequest.state:type_name -> webitel.im.service.admin.v1.PushDelivery.State
+	1, // 3: webitel.im.service.admin.v1.TestPushResponse.outcome:type_name -> webitel.im.service.admin.v1.TestPushResponse.Outcome
+	2, // 4: webitel.im.service.admin.v1.TestPushResponse.cause:type_name -> webitel.im.service.admin.v1.TestPushResponse.Cause
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_service_admin_v1_push_delivery_proto_init() }
+func file_service_admin_v1_push_delivery_proto_init() {
+	if File_service_admin_v1_push_delivery_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_service_admin_v1_push_delivery_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushDelivery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_admin_v1_push_delivery_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushDeliveryList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_admin_v1_push_delivery_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPushDeliveriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_admin_v1_push_delivery_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestPushRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_admin_v1_push_delivery_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestPushResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_service_admin_v1_push_delivery_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_service_admin_v1_push_delivery_proto_goTypes,
+		DependencyIndexes: file_service_admin_v1_push_delivery_proto_depIdxs,
+		EnumInfos:         file_service_admin_v1_push_delivery_proto_enumTypes,
+		MessageInfos:      file_service_admin_v1_push_delivery_proto_msgTypes,
+	}.Build()
+	File_service_admin_v1_push_delivery_proto = out.File
+	file_service_admin_v1_push_delivery_proto_rawDesc = nil
+	file_service_admin_v1_push_delivery_proto_goTypes = nil
+	file_service_admin_v1_push_delivery_proto_depIdxs = nil
+}
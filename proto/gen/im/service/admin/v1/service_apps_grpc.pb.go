@@ -19,10 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Applications_SearchApps_FullMethodName = "/webitel.im.service.admin.v1.Applications/SearchApps"
-	Applications_DeleteApps_FullMethodName = "/webitel.im.service.admin.v1.Applications/DeleteApps"
-	Applications_CreateApp_FullMethodName  = "/webitel.im.service.admin.v1.Applications/CreateApp"
-	Applications_UpdateApp_FullMethodName  = "/webitel.im.service.admin.v1.Applications/UpdateApp"
+	Applications_SearchApps_FullMethodName         = "/webitel.im.service.admin.v1.Applications/SearchApps"
+	Applications_DeleteApps_FullMethodName         = "/webitel.im.service.admin.v1.Applications/DeleteApps"
+	Applications_RevokeApp_FullMethodName          = "/webitel.im.service.admin.v1.Applications/RevokeApp"
+	Applications_CreateApp_FullMethodName          = "/webitel.im.service.admin.v1.Applications/CreateApp"
+	Applications_UpdateApp_FullMethodName          = "/webitel.im.service.admin.v1.Applications/UpdateApp"
+	Applications_ListAppVersions_FullMethodName    = "/webitel.im.service.admin.v1.Applications/ListAppVersions"
+	Applications_RestoreApp_FullMethodName         = "/webitel.im.service.admin.v1.Applications/RestoreApp"
+	Applications_IssueSecret_FullMethodName        = "/webitel.im.service.admin.v1.Applications/IssueSecret"
+	Applications_ListSecrets_FullMethodName        = "/webitel.im.service.admin.v1.Applications/ListSecrets"
+	Applications_RevokeSecret_FullMethodName       = "/webitel.im.service.admin.v1.Applications/RevokeSecret"
+	Applications_ListPushDeliveries_FullMethodName = "/webitel.im.service.admin.v1.Applications/ListPushDeliveries"
+	Applications_TestPush_FullMethodName           = "/webitel.im.service.admin.v1.Applications/TestPush"
 )
 
 // ApplicationsClient is the client API for Applications service.
@@ -33,12 +41,32 @@ const (
 type ApplicationsClient interface {
 	// Search for Application(s)
 	SearchApps(ctx context.Context, in *SearchAppRequest, opts ...grpc.CallOption) (*ApplicationList, error)
-	// Revoke / Delete Application(s)
+	// Delete Application(s) permanently, including all its session(s)
 	DeleteApps(ctx context.Context, in *DeleteAppRequest, opts ...grpc.CallOption) (*ApplicationList, error)
+	// Revoke (block) Application. Invalidates all its session(s)
+	RevokeApp(ctx context.Context, in *RevokeAppRequest, opts ...grpc.CallOption) (*Application, error)
 	// Create NEW Application
 	CreateApp(ctx context.Context, in *CreateAppRequest, opts ...grpc.CallOption) (*Application, error)
 	// Update Application configuration
 	UpdateApp(ctx context.Context, in *UpdateAppRequest, opts ...grpc.CallOption) (*Application, error)
+	// List Application configuration version(s) history
+	ListAppVersions(ctx context.Context, in *ListAppVersionsRequest, opts ...grpc.CallOption) (*AppVersionList, error)
+	// Restore Application configuration from the previous version.
+	// Creates NEW version with the restored configuration.
+	RestoreApp(ctx context.Context, in *RestoreAppRequest, opts ...grpc.CallOption) (*Application, error)
+	// Issue NEW [client_secret] for the Application.
+	// Current active secret(s) expire after the overlap window.
+	IssueSecret(ctx context.Context, in *IssueSecretRequest, opts ...grpc.CallOption) (*ClientSecret, error)
+	// List Application [client_secret](s) ; hash only
+	ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ClientSecretList, error)
+	// Revoke Application [client_secret] immediately
+	RevokeSecret(ctx context.Context, in *RevokeSecretRequest, opts ...grpc.CallOption) (*ClientSecret, error)
+	// List PUSH notification delivery(s) pending or failed ; outbox
+	ListPushDeliveries(ctx context.Context, in *ListPushDeliveriesRequest, opts ...grpc.CallOption) (*PushDeliveryList, error)
+	// Send test PUSH notification synchronously ; diagnostics.
+	// Target: the session device registration or the raw device token.
+	// Returns the PUSH service response ; credentials never echoed.
+	TestPush(ctx context.Context, in *TestPushRequest, opts ...grpc.CallOption) (*TestPushResponse, error)
 }
 
 type applicationsClient struct {
@@ -69,6 +97,16 @@ func (c *applicationsClient) DeleteApps(ctx context.Context, in *DeleteAppReques
 	return out, nil
 }
 
+func (c *applicationsClient) RevokeApp(ctx context.Context, in *RevokeAppRequest, opts ...grpc.CallOption) (*Application, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Application)
+	err := c.cc.Invoke(ctx, Applications_RevokeApp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationsClient) CreateApp(ctx context.Context, in *CreateAppRequest, opts ...grpc.CallOption) (*Application, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Application)
@@ -89,6 +127,76 @@ func (c *applicationsClient) UpdateApp(ctx context.Context, in *UpdateAppRequest
 	return out, nil
 }
 
+func (c *applicationsClient) ListAppVersions(ctx context.Context, in *ListAppVersionsRequest, opts ...grpc.CallOption) (*AppVersionList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AppVersionList)
+	err := c.cc.Invoke(ctx, Applications_ListAppVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationsClient) RestoreApp(ctx context.Context, in *RestoreAppRequest, opts ...grpc.CallOption) (*Application, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Application)
+	err := c.cc.Invoke(ctx, Applications_RestoreApp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationsClient) IssueSecret(ctx context.Context, in *IssueSecretRequest, opts ...grpc.CallOption) (*ClientSecret, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClientSecret)
+	err := c.cc.Invoke(ctx, Applications_IssueSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationsClient) ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ClientSecretList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClientSecretList)
+	err := c.cc.Invoke(ctx, Applications_ListSecrets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationsClient) RevokeSecret(ctx context.Context, in *RevokeSecretRequest, opts ...grpc.CallOption) (*ClientSecret, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClientSecret)
+	err := c.cc.Invoke(ctx, Applications_RevokeSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationsClient) ListPushDeliveries(ctx context.Context, in *ListPushDeliveriesRequest, opts ...grpc.CallOption) (*PushDeliveryList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PushDeliveryList)
+	err := c.cc.Invoke(ctx, Applications_ListPushDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationsClient) TestPush(ctx context.Context, in *TestPushRequest, opts ...grpc.CallOption) (*TestPushResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestPushResponse)
+	err := c.cc.Invoke(ctx, Applications_TestPush_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApplicationsServer is the server API for Applications service.
 // All implementations must embed UnimplementedApplicationsServer
 // for forward compatibility.
@@ -97,12 +205,32 @@ func (c *applicationsClient) UpdateApp(ctx context.Context, in *UpdateAppRequest
 type ApplicationsServer interface {
 	// Search for Application(s)
 	SearchApps(context.Context, *SearchAppRequest) (*ApplicationList, error)
-	// Revoke / Delete Application(s)
+	// Delete Application(s) permanently, including all its session(s)
 	DeleteApps(context.Context, *DeleteAppRequest) (*ApplicationList, error)
+	// Revoke (block) Application. Invalidates all its session(s)
+	RevokeApp(context.Context, *RevokeAppRequest) (*Application, error)
 	// Create NEW Application
 	CreateApp(context.Context, *CreateAppRequest) (*Application, error)
 	// Update Application configuration
 	UpdateApp(context.Context, *UpdateAppRequest) (*Application, error)
+	// List Application configuration version(s) history
+	ListAppVersions(context.Context, *ListAppVersionsRequest) (*AppVersionList, error)
+	// Restore Application configuration from the previous version.
+	// Creates NEW version with the restored configuration.
+	RestoreApp(context.Context, *RestoreAppRequest) (*Application, error)
+	// Issue NEW [client_secret] for the Application.
+	// Current active secret(s) expire after the overlap window.
+	IssueSecret(context.Context, *IssueSecretRequest) (*ClientSecret, error)
+	// List Application [client_secret](s) ; hash only
+	ListSecrets(context.Context, *ListSecretsRequest) (*ClientSecretList, error)
+	// Revoke Application [client_secret] immediately
+	RevokeSecret(context.Context, *RevokeSecretRequest) (*ClientSecret, error)
+	// List PUSH notification delivery(s) pending or failed ; outbox
+	ListPushDeliveries(context.Context, *ListPushDeliveriesRequest) (*PushDeliveryList, error)
+	// Send test PUSH notification synchronously ; diagnostics.
+	// Target: the session device registration or the raw device token.
+	// Returns the PUSH service response ; credentials never echoed.
+	TestPush(context.Context, *TestPushRequest) (*TestPushResponse, error)
 	mustEmbedUnimplementedApplicationsServer()
 }
 
@@ -119,12 +247,36 @@ func (UnimplementedApplicationsServer) SearchApps(context.Context, *SearchAppReq
 func (UnimplementedApplicationsServer) DeleteApps(context.Context, *DeleteAppRequest) (*ApplicationList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteApps not implemented")
 }
+func (UnimplementedApplicationsServer) RevokeApp(context.Context, *RevokeAppRequest) (*Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeApp not implemented")
+}
 func (UnimplementedApplicationsServer) CreateApp(context.Context, *CreateAppRequest) (*Application, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateApp not implemented")
 }
 func (UnimplementedApplicationsServer) UpdateApp(context.Context, *UpdateAppRequest) (*Application, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateApp not implemented")
 }
+func (UnimplementedApplicationsServer) ListAppVersions(context.Context, *ListAppVersionsRequest) (*AppVersionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppVersions not implemented")
+}
+func (UnimplementedApplicationsServer) RestoreApp(context.Context, *RestoreAppRequest) (*Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreApp not implemented")
+}
+func (UnimplementedApplicationsServer) IssueSecret(context.Context, *IssueSecretRequest) (*ClientSecret, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueSecret not implemented")
+}
+func (UnimplementedApplicationsServer) ListSecrets(context.Context, *ListSecretsRequest) (*ClientSecretList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecrets not implemented")
+}
+func (UnimplementedApplicationsServer) RevokeSecret(context.Context, *RevokeSecretRequest) (*ClientSecret, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSecret not implemented")
+}
+func (UnimplementedApplicationsServer) ListPushDeliveries(context.Context, *ListPushDeliveriesRequest) (*PushDeliveryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPushDeliveries not implemented")
+}
+func (UnimplementedApplicationsServer) TestPush(context.Context, *TestPushRequest) (*TestPushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestPush not implemented")
+}
 func (UnimplementedApplicationsServer) mustEmbedUnimplementedApplicationsServer() {}
 func (UnimplementedApplicationsServer) testEmbeddedByValue()                      {}
 
@@ -182,6 +334,24 @@ func _Applications_DeleteApps_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Applications_RevokeApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAppRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServer).RevokeApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Applications_RevokeApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServer).RevokeApp(ctx, req.(*RevokeAppRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Applications_CreateApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateAppRequest)
 	if err := dec(in); err != nil {
@@ -218,6 +388,132 @@ func _Applications_UpdateApp_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Applications_ListAppVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServer).ListAppVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Applications_ListAppVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServer).ListAppVersions(ctx, req.(*ListAppVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Applications_RestoreApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreAppRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServer).RestoreApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Applications_RestoreApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServer).RestoreApp(ctx, req.(*RestoreAppRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Applications_IssueSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServer).IssueSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Applications_IssueSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServer).IssueSecret(ctx, req.(*IssueSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Applications_ListSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSecretsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServer).ListSecrets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Applications_ListSecrets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServer).ListSecrets(ctx, req.(*ListSecretsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Applications_RevokeSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServer).RevokeSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Applications_RevokeSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServer).RevokeSecret(ctx, req.(*RevokeSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Applications_ListPushDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPushDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServer).ListPushDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Applications_ListPushDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServer).ListPushDeliveries(ctx, req.(*ListPushDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Applications_TestPush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestPushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServer).TestPush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Applications_TestPush_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServer).TestPush(ctx, req.(*TestPushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Applications_ServiceDesc is the grpc.ServiceDesc for Applications service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -233,6 +529,10 @@ var Applications_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteApps",
 			Handler:    _Applications_DeleteApps_Handler,
 		},
+		{
+			MethodName: "RevokeApp",
+			Handler:    _Applications_RevokeApp_Handler,
+		},
 		{
 			MethodName: "CreateApp",
 			Handler:    _Applications_CreateApp_Handler,
@@ -241,6 +541,34 @@ var Applications_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateApp",
 			Handler:    _Applications_UpdateApp_Handler,
 		},
+		{
+			MethodName: "ListAppVersions",
+			Handler:    _Applications_ListAppVersions_Handler,
+		},
+		{
+			MethodName: "RestoreApp",
+			Handler:    _Applications_RestoreApp_Handler,
+		},
+		{
+			MethodName: "IssueSecret",
+			Handler:    _Applications_IssueSecret_Handler,
+		},
+		{
+			MethodName: "ListSecrets",
+			Handler:    _Applications_ListSecrets_Handler,
+		},
+		{
+			MethodName: "RevokeSecret",
+			Handler:    _Applications_RevokeSecret_Handler,
+		},
+		{
+			MethodName: "ListPushDeliveries",
+			Handler:    _Applications_ListPushDeliveries_Handler,
+		},
+		{
+			MethodName: "TestPush",
+			Handler:    _Applications_TestPush_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service/admin/v1/service_apps.proto",
@@ -20,6 +20,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PUSHSubscription_Type int32
+
+const (
+	// Regular (visible) notifications token.
+	PUSHSubscription_ALERT PUSHSubscription_Type = 0
+	// Incoming call(s) notifications token, e.g.: iOS PushKit.
+	// [apn]: sent with the [.voip] topic suffix.
+	PUSHSubscription_VOIP PUSHSubscription_Type = 1
+)
+
+// Enum value maps for PUSHSubscription_Type.
+var (
+	PUSHSubscription_Type_name = map[int32]string{
+		0: "ALERT",
+		1: "VOIP",
+	}
+	PUSHSubscription_Type_value = map[string]int32{
+		"ALERT": 0,
+		"VOIP":  1,
+	}
+)
+
+func (x PUSHSubscription_Type) Enum() *PUSHSubscription_Type {
+	p := new(PUSHSubscription_Type)
+	*p = x
+	return p
+}
+
+func (x PUSHSubscription_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PUSHSubscription_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_auth_v1_device_push_proto_enumTypes[0].Descriptor()
+}
+
+func (PUSHSubscription_Type) Type() protoreflect.EnumType {
+	return &file_service_auth_v1_device_push_proto_enumTypes[0]
+}
+
+func (x PUSHSubscription_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PUSHSubscription_Type.Descriptor instead.
+func (PUSHSubscription_Type) EnumDescriptor() ([]byte, []int) {
+	return file_service_auth_v1_device_push_proto_rawDescGZIP(), []int{0, 0}
+}
+
 // PUSH (token) Subscription
 // https://core.telegram.org/api/push-updates#subscribing-to-notifications
 type PUSHSubscription struct {
@@ -37,6 +86,9 @@ type PUSHSubscription struct {
 	Token isPUSHSubscription_Token `protobuf_oneof:"token"`
 	// For FCM and APNS VoIP, optional encryption key used to encrypt push notifications
 	Secret []byte `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Registration type of the [fcm] or [apn] token.
+	// Session device holds one registration per type: alert, voip and web.
+	Type PUSHSubscription_Type `protobuf:"varint,5,opt,name=type,proto3,enum=webitel.im.service.auth.v1.PUSHSubscription_Type" json:"type,omitempty"`
 }
 
 func (x *PUSHSubscription) Reset() {
@@ -106,6 +158,13 @@ func (x *PUSHSubscription) GetSecret() []byte {
 	return nil
 }
 
+func (x *PUSHSubscription) GetType() PUSHSubscription_Type {
+	if x != nil {
+		return x.Type
+	}
+	return PUSHSubscription_ALERT
+}
+
 type isPUSHSubscription_Token interface {
 	isPUSHSubscription_Token()
 }
@@ -260,7 +319,7 @@ var file_service_auth_v1_device_push_proto_rawDesc = []byte{
 	0x31, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e,
 	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x22,
-	0xa0, 0x01, 0x0a, 0x10, 0x50, 0x55, 0x53, 0x48, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x84, 0x02, 0x0a, 0x10, 0x50, 0x55, 0x53, 0x48, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
 	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x03, 0x66, 0x63, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x48, 0x00, 0x52, 0x03, 0x66, 0x63, 0x6d, 0x12, 0x12, 0x0a, 0x03, 0x61, 0x70, 0x6e, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x61, 0x70, 0x6e, 0x12, 0x43, 0x0a, 0x03,
@@ -269,34 +328,41 @@ var file_service_auth_v1_device_push_proto_rawDesc = []byte{
 	0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x50, 0x75, 0x73, 0x68, 0x53, 0x75,
 	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x77, 0x65,
 	0x62, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x22, 0xab, 0x01, 0x0a, 0x13, 0x57, 0x65, 0x62, 0x50, 0x75, 0x73, 0x68, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e,
-	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e,
-	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x45, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31,
-	0x2e, 0x57, 0x65, 0x62, 0x50, 0x75, 0x73, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x1a, 0x31, 0x0a,
-	0x03, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x32, 0x35, 0x36,
-	0x64, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x32, 0x35, 0x36, 0x64, 0x68,
-	0x42, 0xf3, 0x01, 0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c,
-	0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x2e, 0x76, 0x31, 0x42, 0x0f, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x50, 0x75, 0x73, 0x68, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x73, 0x2f, 0x69, 0x6d, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x75, 0x74,
-	0x68, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x75, 0x74, 0x68, 0x70, 0x62, 0xa2, 0x02, 0x04, 0x57, 0x49,
-	0x53, 0x41, 0xaa, 0x02, 0x1a, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x49, 0x6d, 0x2e,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x56, 0x31, 0xca,
-	0x02, 0x1a, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d, 0x5c, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x5c, 0x41, 0x75, 0x74, 0x68, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x26, 0x57,
-	0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d, 0x5c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x5c, 0x41, 0x75, 0x74, 0x68, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1e, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x3a,
-	0x3a, 0x49, 0x6d, 0x3a, 0x3a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3a, 0x3a, 0x41, 0x75,
-	0x74, 0x68, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x0c, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x45, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65,
+	0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x55, 0x53, 0x48, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0x1b, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x4c, 0x45, 0x52,
+	0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x4f, 0x49, 0x50, 0x10, 0x01, 0x42, 0x07, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xab, 0x01, 0x0a, 0x13, 0x57, 0x65, 0x62, 0x50, 0x75,
+	0x73, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x45, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65,
+	0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x50, 0x75, 0x73, 0x68, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x1a, 0x31, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x32, 0x35, 0x36, 0x64, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x32,
+	0x35, 0x36, 0x64, 0x68, 0x42, 0xf3, 0x01, 0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x65, 0x62,
+	0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x42, 0x0f, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x50,
+	0x75, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x69, 0x6d, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x75, 0x74, 0x68, 0x70, 0x62, 0xa2,
+	0x02, 0x04, 0x57, 0x49, 0x53, 0x41, 0xaa, 0x02, 0x1a, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c,
+	0x2e, 0x49, 0x6d, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x1a, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d,
+	0x5c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5c, 0x41, 0x75, 0x74, 0x68, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x26, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d, 0x5c, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5c, 0x41, 0x75, 0x74, 0x68, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1e, 0x57, 0x65, 0x62, 0x69,
+	0x74, 0x65, 0x6c, 0x3a, 0x3a, 0x49, 0x6d, 0x3a, 0x3a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x3a, 0x3a, 0x41, 0x75, 0x74, 0x68, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -311,20 +377,23 @@ func file_service_auth_v1_device_push_proto_rawDescGZIP() []byte {
 	return file_service_auth_v1_device_push_proto_rawDescData
 }
 
+var file_service_auth_v1_device_push_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_service_auth_v1_device_push_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_service_auth_v1_device_push_proto_goTypes = []interface{}{
-	(*PUSHSubscription)(nil),        // 0: webitel.im.service.auth.v1.PUSHSubscription
-	(*WebPushSubscription)(nil),     // 1: webitel.im.service.auth.v1.WebPushSubscription
-	(*WebPushSubscription_Key)(nil), // 2: webitel.im.service.auth.v1.WebPushSubscription.Key
+	(PUSHSubscription_Type)(0),      // 0: webitel.im.service.auth.v1.PUSHSubscription.Type
+	(*PUSHSubscription)(nil),        // 1: webitel.im.service.auth.v1.PUSHSubscription
+	(*WebPushSubscription)(nil),     // 2: webitel.im.service.auth.v1.WebPushSubscription
+	(*WebPushSubscription_Key)(nil), // 3: webitel.im.service.auth.v1.WebPushSubscription.Key
 }
 var file_service_auth_v1_device_push_proto_depIdxs = []int32{
-	1, // 0: webitel.im.service.auth.v1.PUSHSubscription.web:type_name -> webitel.im.service.auth.v1.WebPushSubscription
-	2, // 1: webitel.im.service.auth.v1.WebPushSubscription.key:type_name -> webitel.im.service.auth.v1.WebPushSubscription.Key
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2, // 0: webitel.im.service.auth.v1.PUSHSubscription.web:type_name -> webitel.im.service.auth.v1.WebPushSubscription
+	0, // 1: webitel.im.service.auth.v1.PUSHSubscription.type:type_name -> webitel.im.service.auth.v1.PUSHSubscription.Type
+	3, // 2: webitel.im.service.auth.v1.WebPushSubscription.key:type_name -> webitel.im.service.auth.v1.WebPushSubscription.Key
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_service_auth_v1_device_push_proto_init() }
@@ -380,13 +449,14 @@ func file_service_auth_v1_device_push_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_service_auth_v1_device_push_proto_rawDesc,
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_service_auth_v1_device_push_proto_goTypes,
 		DependencyIndexes: file_service_auth_v1_device_push_proto_depIdxs,
+		EnumInfos:         file_service_auth_v1_device_push_proto_enumTypes,
 		MessageInfos:      file_service_auth_v1_device_push_proto_msgTypes,
 	}.Build()
 	File_service_auth_v1_device_push_proto = out.File